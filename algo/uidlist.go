@@ -9,6 +9,7 @@ package algo
 
 import (
 	"container/heap"
+	"math/rand"
 	"sort"
 
 	"github.com/dgraph-io/dgraph/bp128"
@@ -380,6 +381,23 @@ func MergeSorted(lists []*intern.List) *intern.List {
 	return &intern.List{Uids: output}
 }
 
+// Sample keeps n uids of the list chosen uniformly at random with r, in the order they were in.
+// The list is left as it is if it doesn't have more than n uids.
+func Sample(u *intern.List, n int, r *rand.Rand) {
+	if len(u.Uids) <= n {
+		return
+	}
+	// Selection sampling picks every uid with the probability of being among the rest still
+	// needed.
+	out := make([]uint64, 0, n)
+	for i, uid := range u.Uids {
+		if r.Intn(len(u.Uids)-i) < n-len(out) {
+			out = append(out, uid)
+		}
+	}
+	u.Uids = out
+}
+
 // IndexOf performs a binary search on the uids slice and returns the index at
 // which it finds the uid, else returns -1
 func IndexOf(u *intern.List, uid uint64) int {
@@ -276,6 +276,26 @@ func TestApplyFilterUint(t *testing.T) {
 	require.Equal(t, []uint64{1, 3, 5}, u.Uids)
 }
 
+func TestSample(t *testing.T) {
+	l := []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	u := newList(l)
+	Sample(u, 4, rand.New(rand.NewSource(1)))
+	require.Equal(t, 4, len(u.Uids))
+	require.True(t, sort.SliceIsSorted(u.Uids, func(i, j int) bool { return u.Uids[i] < u.Uids[j] }))
+	for _, uid := range u.Uids {
+		require.True(t, IndexOf(newList(l), uid) >= 0)
+	}
+
+	// The same seed gives the same sample.
+	v := newList(l)
+	Sample(v, 4, rand.New(rand.NewSource(1)))
+	require.Equal(t, u.Uids, v.Uids)
+
+	w := newList(l[:3])
+	Sample(w, 4, rand.New(rand.NewSource(1)))
+	require.Equal(t, []uint64{1, 2, 3}, w.Uids)
+}
+
 // Benchmarks for IntersectWith
 func BenchmarkListIntersectRandom(b *testing.B) {
 	randomTests := func(arrSz int, overlap float64) {
@@ -88,7 +88,8 @@ func newLoader(opt options) *loader {
 		rdfChunkCh: make(chan *bytes.Buffer, opt.NumGoroutines),
 		writeTs:    getWriteTimestamp(zero),
 	}
-	st.schema = newSchemaStore(readSchema(opt.SchemaFile), opt, st)
+	initialSchema, initialTypes := readSchema(opt.SchemaFile)
+	st.schema = newSchemaStore(initialSchema, initialTypes, opt, st)
 	ld := &loader{
 		state:   st,
 		mappers: make([]*mapper, opt.NumGoroutines),
@@ -115,7 +116,7 @@ func getWriteTimestamp(zero *grpc.ClientConn) uint64 {
 	}
 }
 
-func readSchema(filename string) []*intern.SchemaUpdate {
+func readSchema(filename string) ([]*intern.SchemaUpdate, []*intern.TypeUpdate) {
 	f, err := os.Open(filename)
 	x.Check(err)
 	defer f.Close()
@@ -128,9 +129,9 @@ func readSchema(filename string) []*intern.SchemaUpdate {
 	buf, err := ioutil.ReadAll(r)
 	x.Check(err)
 
-	initialSchema, err := schema.Parse(string(buf))
+	initialSchema, initialTypes, err := schema.ParseWithTypes(string(buf))
 	x.Check(err)
-	return initialSchema
+	return initialSchema, initialTypes
 }
 
 func readChunk(r *bufio.Reader) (*bytes.Buffer, error) {
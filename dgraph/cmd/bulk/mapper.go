@@ -26,6 +26,7 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/rdf"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
@@ -38,6 +39,8 @@ import (
 type mapper struct {
 	*state
 	shards []shardState // shard is based on predicate
+	// Full text tokenizers built from the analyzer configs of the schema, by predicate.
+	analyzers map[string]tok.Tokenizer
 }
 
 type shardState struct {
@@ -49,8 +52,9 @@ type shardState struct {
 
 func newMapper(st *state) *mapper {
 	return &mapper{
-		state:  st,
-		shards: make([]shardState, st.opt.MapShards),
+		state:     st,
+		shards:    make([]shardState, st.opt.MapShards),
+		analyzers: make(map[string]tok.Tokenizer),
 	}
 }
 
@@ -149,7 +153,8 @@ func (m *mapper) addMapEntry(key []byte, p *intern.Posting, shard int) {
 	me := &intern.MapEntry{
 		Key: key,
 	}
-	if p.PostingType != intern.Posting_REF || len(p.Facets) > 0 {
+	if p.PostingType != intern.Posting_REF || len(p.Facets) > 0 || len(p.Positions) > 0 ||
+		len(p.Value) > 0 {
 		me.Posting = p
 	} else {
 		me.Uid = p.Uid
@@ -281,6 +286,16 @@ func (m *mapper) createPostings(nq gql.NQuad,
 	return p, rp
 }
 
+func (m *mapper) fullTextAnalyzer(pred string, cfg *intern.AnalyzerConfig) tok.Tokenizer {
+	if toker, ok := m.analyzers[pred]; ok {
+		return toker
+	}
+	toker, err := schema.FullTextTokenizer(cfg)
+	x.Check(err)
+	m.analyzers[pred] = toker
+	return toker
+}
+
 func (m *mapper) addIndexMapEntries(nq gql.NQuad, de *intern.DirectedEdge) {
 	if nq.GetObjectValue() == nil {
 		return // Cannot index UIDs
@@ -295,6 +310,9 @@ func (m *mapper) addIndexMapEntries(nq gql.NQuad, de *intern.DirectedEdge) {
 		if !ok {
 			log.Fatalf("unknown tokenizer %q", tokerName)
 		}
+		if sch.Analyzer != nil && tokerName == tok.FtsTokenizerName("") {
+			toker = m.fullTextAnalyzer(nq.Predicate, sch.Analyzer)
+		}
 
 		// Create storage value.
 		storageVal := types.Val{
@@ -312,14 +330,40 @@ func (m *mapper) addIndexMapEntries(nq gql.NQuad, de *intern.DirectedEdge) {
 		toks, err := tok.BuildTokens(schemaVal.Value, toker)
 		x.Check(err)
 
+		// Full text tokens also carry the positions of their words, and the number of words
+		// of the value is kept for relevance scoring.
+		var positions map[string][]uint32
+		if toker.Identifier() == (tok.FullTextTokenizer{}).Identifier() {
+			textToks, err := tok.TokenPositions(toker, schemaVal.Value.(string))
+			x.Check(err)
+			if !sch.List && !sch.Lang {
+				// As in posting.addIndexMutations, the values of list and language tagged
+				// predicates don't get positions or lengths, since they share their postings.
+				positions = tok.PositionsByToken(textToks)
+				m.addMapEntry(
+					x.IndexKey(nq.Predicate, tok.FullTextLengthsToken()),
+					&intern.Posting{
+						Uid:         de.GetEntity(),
+						Value:       x.AppendUvarint(nil, uint64(len(textToks))),
+						PostingType: intern.Posting_REF,
+					},
+					m.state.shards.shardFor(nq.Predicate),
+				)
+			}
+		}
+
 		// Store index posting.
 		for _, t := range toks {
+			p := &intern.Posting{
+				Uid:         de.GetEntity(),
+				PostingType: intern.Posting_REF,
+			}
+			if pos, ok := positions[t]; ok {
+				p.Positions = tok.EncodePositions(pos)
+			}
 			m.addMapEntry(
 				x.IndexKey(nq.Predicate, t),
-				&intern.Posting{
-					Uid:         de.GetEntity(),
-					PostingType: intern.Posting_REF,
-				},
+				p,
 				m.state.shards.shardFor(nq.Predicate),
 			)
 		}
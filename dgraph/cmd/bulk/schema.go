@@ -21,18 +21,27 @@ import (
 
 type schemaStore struct {
 	sync.RWMutex
-	m map[string]*intern.SchemaUpdate
+	m     map[string]*intern.SchemaUpdate
+	types []*intern.TypeUpdate
 	*state
 }
 
-func newSchemaStore(initial []*intern.SchemaUpdate, opt options, state *state) *schemaStore {
+func newSchemaStore(initial []*intern.SchemaUpdate, types []*intern.TypeUpdate, opt options,
+	state *state) *schemaStore {
 	s := &schemaStore{
 		m: map[string]*intern.SchemaUpdate{
 			"_predicate_": &intern.SchemaUpdate{
 				ValueType: intern.Posting_STRING,
 				List:      true,
 			},
+			"_type_": &intern.SchemaUpdate{
+				ValueType: intern.Posting_STRING,
+				Directive: intern.SchemaUpdate_INDEX,
+				Tokenizer: []string{"exact"},
+				List:      true,
+			},
 		},
+		types: types,
 		state: state,
 	}
 	if opt.StoreXids {
@@ -92,5 +101,11 @@ func (s *schemaStore) write(db *badger.ManagedDB) {
 		x.Check(err)
 		x.Check(txn.SetWithMeta(k, v, posting.BitCompletePosting))
 	}
+	// Every group keeps a copy of the type definitions.
+	for _, typ := range s.types {
+		v, err := typ.Marshal()
+		x.Check(err)
+		x.Check(txn.Set(x.TypeKey(typ.TypeName), v))
+	}
 	x.Check(txn.CommitAt(1, nil))
 }
@@ -0,0 +1,151 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package cdc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/spf13/cobra"
+)
+
+var CDC x.SubCommand
+
+func init() {
+	CDC.Cmd = &cobra.Command{
+		Use:   "cdc",
+		Short: "Stream the changes committed to a Dgraph server",
+		Long: `
+Prints a JSON line for every edge set or deleted by the transactions committed on a
+Dgraph server, with its commit and start timestamps. A server only streams the edges
+of the predicates served by its group, so run one per group to see every change.
+
+After a disconnect, the stream is resumed right after the last change printed, by its
+position in the server's change log. Commits aren't always applied in timestamp order,
+so resuming by commit timestamp could skip some. If the server restarted, or no longer
+keeps the changes following the last one printed, the stream can't be resumed without
+losing changes, and reconnecting fails.
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			defer x.StartProfile(CDC.Conf).Stop()
+			run()
+		},
+	}
+	CDC.EnvPrefix = "DGRAPH_CDC"
+
+	flag := CDC.Cmd.Flags()
+	flag.StringP("dgraph", "d", "127.0.0.1:9080", "Dgraph gRPC server address")
+	flag.Uint64("since", 0,
+		"Print the changes committed after this timestamp. Zero starts from the oldest change"+
+			" kept by the server.")
+	flag.Duration("retry", 5*time.Second, "Time to wait before reconnecting after a disconnect.")
+}
+
+type change struct {
+	CommitTs  uint64 `json:"commit_ts"`
+	StartTs   uint64 `json:"start_ts"`
+	Op        string `json:"op"`
+	Subject   string `json:"subject"`
+	Predicate string `json:"predicate"`
+	Object    string `json:"object,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Lang      string `json:"lang,omitempty"`
+}
+
+func toChange(e *intern.ChangeEvent) change {
+	edge := e.Edge
+	c := change{
+		CommitTs:  e.CommitTs,
+		StartTs:   e.StartTs,
+		Op:        "set",
+		Subject:   fmt.Sprintf("%#x", edge.Entity),
+		Predicate: edge.Attr,
+		Lang:      edge.Lang,
+	}
+	if edge.Op == intern.DirectedEdge_DEL {
+		c.Op = "del"
+	}
+	if len(edge.Value) == 0 && edge.ValueId != 0 {
+		c.Object = fmt.Sprintf("%#x", edge.ValueId)
+		return c
+	}
+	if string(edge.Value) == x.Star {
+		c.Value = "*"
+		return c
+	}
+	tid := types.TypeID(edge.ValueType)
+	c.Type = tid.Name()
+	src := types.Val{Tid: tid, Value: edge.Value}
+	if v, err := types.Convert(src, types.StringID); err == nil {
+		c.Value = v.Value.(string)
+	} else {
+		c.Value = string(edge.Value)
+	}
+	return c
+}
+
+// stream prints the changes requested, and returns the request resuming the stream right after
+// the last one printed.
+func stream(ctx context.Context, addr string, req *intern.ChangesRequest) (*intern.ChangesRequest,
+	error) {
+	conn, err := grpc.Dial(addr,
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(x.GrpcMaxSize)),
+		grpc.WithInsecure(),
+		grpc.WithBlock(),
+		grpc.WithTimeout(10*time.Second))
+	if err != nil {
+		return req, err
+	}
+	defer conn.Close()
+
+	changes, err := intern.NewSubscriptionsClient(conn).Changes(ctx, req)
+	if err != nil {
+		return req, err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		e, err := changes.Recv()
+		if err == io.EOF {
+			return req, nil
+		}
+		if err != nil {
+			return req, err
+		}
+		if err := enc.Encode(toChange(e)); err != nil {
+			return req, err
+		}
+		req = &intern.ChangesRequest{LogId: e.LogId, AfterSeq: e.Seq}
+	}
+}
+
+func run() {
+	addr := CDC.Conf.GetString("dgraph")
+	req := &intern.ChangesRequest{SinceTs: uint64(CDC.Conf.GetInt64("since"))}
+	retry := CDC.Conf.GetDuration("retry")
+
+	for {
+		var err error
+		req, err = stream(context.Background(), addr, req)
+		if err != nil {
+			log.Printf("Change stream from %s ended: %v", addr, err)
+		}
+		time.Sleep(retry)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"sort"
 	"strconv"
@@ -87,25 +88,40 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if vars := r.Header.Get("X-Dgraph-Vars"); vars != "" {
-		req.Vars = map[string]string{}
-		if err := json.Unmarshal([]byte(vars), &req.Vars); err != nil {
-			x.SetStatus(w, x.ErrorInvalidRequest,
-				"Error while unmarshalling Vars header into map")
-			return
-		}
-	}
-
-	defer r.Body.Close()
-	q, err := ioutil.ReadAll(r.Body)
-	if err != nil {
+	if err := readQuery(r, &req); err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
-	req.Query = string(q)
 
 	d := r.URL.Query().Get("debug")
 	ctx := context.WithValue(context.Background(), "debug", d)
+	// Override the limits of the server for this query.
+	if timeout := r.URL.Query().Get("timeout"); timeout != "" {
+		ctx = context.WithValue(ctx, edgraph.TimeoutKey, timeout)
+	}
+	if budget := r.URL.Query().Get("memory_budget"); budget != "" {
+		ctx = context.WithValue(ctx, edgraph.MemoryBudgetKey, budget)
+	}
+	// Read a past snapshot of the data.
+	if readTs := r.URL.Query().Get("readTs"); readTs != "" {
+		ctx = context.WithValue(ctx, edgraph.ReadTsKey, readTs)
+	}
+	if asOf := r.URL.Query().Get("asOf"); asOf != "" {
+		ctx = context.WithValue(ctx, edgraph.AsOfKey, asOf)
+	}
+	var explain *[]*query.ExplainNode
+	if ex := r.URL.Query().Get("explain"); ex != "" {
+		on, err := strconv.ParseBool(ex)
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, "Error while parsing explain param as bool")
+			return
+		}
+		if on {
+			// Query returns the execution statistics of the SubGraphs through the context.
+			explain = new([]*query.ExplainNode)
+			ctx = context.WithValue(ctx, edgraph.ExplainKey, explain)
+		}
+	}
 	resp, err := (&edgraph.Server{}).Query(ctx, &req)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
@@ -118,6 +134,9 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		Txn:     resp.Txn,
 		Latency: resp.Latency,
 	}
+	if explain != nil {
+		e.Explain = *explain
+	}
 	response["extensions"] = e
 
 	// User can either ask for schema or have a query.
@@ -144,6 +163,114 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// queryJSONType is the Content-Type of a query sent in a JSON object with its variables. It's
+// distinct from application/json, which clients send raw queries as.
+const queryJSONType = "application/graphql+json"
+
+// readQuery reads the query and its GraphQL variables from the request. The body is either the
+// query, with the variables in the X-Dgraph-Vars header, or a JSON object with the query and
+// variables if its Content-Type is queryJSONType. Values that aren't strings, like the JSON
+// arrays of list variables, are passed on as JSON.
+func readQuery(r *http.Request, req *api.Request) error {
+	defer r.Body.Close()
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	var vars map[string]json.RawMessage
+	if header := r.Header.Get("X-Dgraph-Vars"); header != "" {
+		if err := json.Unmarshal([]byte(header), &vars); err != nil {
+			return x.Errorf("Error while unmarshalling Vars header into map")
+		}
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == queryJSONType {
+		var q struct {
+			Query     string                     `json:"query"`
+			Variables map[string]json.RawMessage `json:"variables"`
+		}
+		if err := json.Unmarshal(body, &q); err != nil {
+			return x.Errorf("Error while unmarshalling the query and variables in the body")
+		}
+		body = []byte(q.Query)
+		if vars == nil {
+			vars = q.Variables
+		} else {
+			for k, v := range q.Variables {
+				vars[k] = v
+			}
+		}
+	}
+	req.Query = string(body)
+
+	if len(vars) == 0 {
+		return nil
+	}
+	req.Vars = make(map[string]string, len(vars))
+	for k, v := range vars {
+		var val string
+		if err := json.Unmarshal(v, &val); err != nil {
+			val = strings.TrimSpace(string(v))
+		}
+		req.Vars[k] = val
+	}
+	return nil
+}
+
+// subscribeHandler streams the results of a query as server-sent events. A new event is sent
+// whenever the result changes after a transaction commits, until the client disconnects.
+func subscribeHandler(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+
+	if !allowed(r.Method) {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		x.SetStatus(w, x.Error, "Streaming is not supported")
+		return
+	}
+
+	req := api.Request{}
+	if err := readQuery(r, &req); err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	send := func(resp *api.Response) error {
+		response := map[string]interface{}{}
+		response["extensions"] = query.Extensions{
+			Txn:     resp.Txn,
+			Latency: resp.Latency,
+		}
+		response["data"] = json.RawMessage(string(resp.Json))
+		js, err := json.Marshal(response)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", js); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+	if err := (&edgraph.Server{}).Watch(r.Context(), &req, send); err != nil {
+		// The headers might have been sent already, so the error is sent as an event.
+		js, _ := json.Marshal(map[string]string{"code": x.ErrorInvalidRequest,
+			"message": err.Error()})
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", js)
+		flusher.Flush()
+	}
+}
+
 func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
@@ -165,8 +292,25 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	parseStart := time.Now()
-	mu, err := gql.ParseMutation(string(m))
-	if err != nil {
+	ctx := context.Background()
+	var mu *api.Mutation
+	var applied *bool
+	if gql.IsUpsert(string(m)) {
+		up, err := gql.ParseUpsert(string(m))
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+			return
+		}
+		// The query is executed by Mutate as part of the same transaction.
+		ctx = context.WithValue(ctx, edgraph.UpsertKey, up.Query)
+		if len(up.Cond) > 0 {
+			// Mutate reports whether the condition held and the mutation was applied.
+			applied = new(bool)
+			ctx = context.WithValue(ctx, edgraph.UpsertIfKey, up.Cond)
+			ctx = context.WithValue(ctx, edgraph.UpsertAppliedKey, applied)
+		}
+		mu = up.Mutation
+	} else if mu, err = gql.ParseMutation(string(m)); err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
@@ -191,7 +335,7 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	mu.StartTs = ts
 
-	resp, err := (&edgraph.Server{}).Mutate(context.Background(), mu)
+	resp, err := (&edgraph.Server{}).Mutate(ctx, mu)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
@@ -214,6 +358,9 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	mp["code"] = x.Success
 	mp["message"] = "Done"
 	mp["uids"] = resp.Uids
+	if applied != nil {
+		mp["applied"] = *applied
+	}
 	response["data"] = mp
 
 	js, err := json.Marshal(response)
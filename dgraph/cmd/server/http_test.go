@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
@@ -195,3 +196,36 @@ func TestAlterAllFieldsShouldBeSet(t *testing.T) {
 	require.Len(t, qr.Errors, 1)
 	require.Equal(t, qr.Errors[0].Code, "Error")
 }
+
+func TestReadQueryVars(t *testing.T) {
+	r, err := http.NewRequest("POST", "/query", bytes.NewBufferString(`{
+		"query": "query test($ids: [uid], $name: string) { me(func: uid($ids)) { name } }",
+		"variables": {"$ids": ["0x1", "0x2"], "$name": "Alice"}
+	}`))
+	require.NoError(t, err)
+	r.Header.Set("Content-Type", "application/graphql+json; charset=utf-8")
+	r.Header.Set("X-Dgraph-Vars", `{"$name": "Bob", "$age": 30}`)
+
+	var req api.Request
+	require.NoError(t, readQuery(r, &req))
+	require.Contains(t, req.Query, "query test($ids: [uid], $name: string)")
+	require.Equal(t, map[string]string{
+		"$ids":  `["0x1", "0x2"]`,
+		"$name": "Alice",
+		"$age":  "30",
+	}, req.Vars)
+}
+
+func TestReadQueryRawJSON(t *testing.T) {
+	// Clients send raw queries as application/json too.
+	query := `{ me(func: uid(1)) { name } }`
+	r, err := http.NewRequest("POST", "/query", bytes.NewBufferString(query))
+	require.NoError(t, err)
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("X-Dgraph-Vars", `{"$name": "Bob"}`)
+
+	var req api.Request
+	require.NoError(t, readQuery(r, &req))
+	require.Equal(t, query, req.Query)
+	require.Equal(t, map[string]string{"$name": "Bob"}, req.Vars)
+}
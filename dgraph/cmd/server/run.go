@@ -28,6 +28,7 @@ import (
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/worker"
@@ -89,6 +90,10 @@ func init() {
 	flag.Bool("expand_edge", defaults.ExpandEdge,
 		"Enables the expand() feature. This is very expensive for large data loads because it"+
 			" doubles the number of mutations going on in the system.")
+	flag.Int("cdc_events", defaults.ChangeLogSize,
+		"Number of committed edges kept for change data capture streams. Zero disables them.")
+	flag.Duration("history_retention", defaults.HistoryRetention,
+		"How long the past versions of the data are kept for point-in-time queries.")
 
 	flag.Float64("lru_mb", defaults.AllottedMemory,
 		"Estimated memory the LRU cache can take. "+
@@ -109,6 +114,12 @@ func init() {
 	flag.Uint64("query_edge_limit", 1e6,
 		"Limit for the maximum number of edges that can be returned in a query."+
 			" This is only useful for shortest path queries.")
+	flag.Duration("query_timeout", 0,
+		"Default time after which a query is cancelled. Requests can override it."+
+			" Zero means no timeout.")
+	flag.Uint64("query_memory_mb", 0,
+		"Default memory budget in MB for the results of a query, after which it is cancelled."+
+			" Requests can override it. Zero means no budget.")
 
 	// TLS configurations
 	x.RegisterTLSFlags(flag)
@@ -195,6 +206,7 @@ func serveGRPC(l net.Listener, wg *sync.WaitGroup) {
 		grpc.MaxSendMsgSize(x.GrpcMaxSize),
 		grpc.MaxConcurrentStreams(1000))
 	api.RegisterDgraphServer(s, &edgraph.Server{})
+	intern.RegisterSubscriptionsServer(s, &edgraph.Server{})
 	err := s.Serve(l)
 	log.Printf("gRpc server stopped : %s", err.Error())
 	s.GracefulStop()
@@ -238,6 +250,7 @@ func setupServer() {
 
 	http.HandleFunc("/query", queryHandler)
 	http.HandleFunc("/query/", queryHandler)
+	http.HandleFunc("/subscribe", subscribeHandler)
 	http.HandleFunc("/mutate", mutationHandler)
 	http.HandleFunc("/mutate/", mutationHandler)
 	http.HandleFunc("/commit/", commitHandler)
@@ -290,6 +303,8 @@ func run() {
 		RaftId:              uint64(Server.Conf.GetInt("idx")),
 		MaxPendingCount:     uint64(Server.Conf.GetInt("sc")),
 		ExpandEdge:          Server.Conf.GetBool("expand_edge"),
+		ChangeLogSize:       Server.Conf.GetInt("cdc_events"),
+		HistoryRetention:    Server.Conf.GetDuration("history_retention"),
 		DebugMode:           Server.Conf.GetBool("debugmode"),
 	}
 
@@ -303,6 +318,8 @@ func run() {
 	setupCustomTokenizers()
 	x.Init(edgraph.Config.DebugMode)
 	x.Config.QueryEdgeLimit = cast.ToUint64(Server.Conf.GetString("query_edge_limit"))
+	x.Config.QueryTimeout = Server.Conf.GetDuration("query_timeout")
+	x.Config.QueryMemoryBudget = cast.ToUint64(Server.Conf.GetString("query_memory_mb")) << 20
 
 	edgraph.InitServerState()
 	defer func() {
@@ -34,6 +34,8 @@ func (s *Server) updateLeases() {
 	startTs = s.nextTxnTs
 	s.Unlock()
 	s.orc.updateStartTxnTs(startTs)
+	// The previous leader handed out at most the timestamps it had leased.
+	s.orc.markTime(startTs - 1)
 }
 
 func (s *Server) maxLeaseId() uint64 {
@@ -104,6 +106,7 @@ func (s *Server) lease(ctx context.Context, num *intern.Num, txn bool) (*api.Ass
 		out.EndId = out.StartId + num.Val - 1
 		s.nextTxnTs = out.EndId + 1
 		s.orc.doneUntil.Begin(out.EndId)
+		s.orc.markTime(out.EndId)
 	} else {
 		out.StartId = s.nextLeaseId
 		out.EndId = out.StartId + num.Val - 1
@@ -11,6 +11,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/dgraph-io/dgo/protos/api"
@@ -24,6 +25,23 @@ type syncMark struct {
 	ts    uint64
 }
 
+// timeMark records the highest timestamp handed out by a point in time.
+type timeMark struct {
+	at time.Time
+	ts uint64
+}
+
+// Enough to map times from the last twelve days on a busy cluster.
+const maxTimeMarks = 1 << 20
+
+const (
+	// How often the leader keeps its latest time mark in the membership state.
+	timeMarkInterval = time.Minute
+	// Marks kept in the membership state. When they run out, every other one of the older half
+	// is dropped, so the oldest times are mapped less accurately, but never forgotten.
+	maxStateTimeMarks = 1 << 12
+)
+
 type Oracle struct {
 	x.SafeMutex
 	commits map[uint64]uint64 // startTs -> commitTs
@@ -40,6 +58,9 @@ type Oracle struct {
 	updates     chan *intern.OracleDelta
 	doneUntil   x.WaterMark
 	syncMarks   []syncMark
+	// At most one per second, ordered by time, starting from when this Zero became leader. Older
+	// times are mapped by the coarser marks kept in the membership state.
+	timeMarks []timeMark
 }
 
 func (o *Oracle) Init() {
@@ -253,6 +274,115 @@ func (o *Oracle) storePending(ids *api.AssignedIds) {
 	}
 }
 
+// markTime records that timestamps up to ts have been handed out by now.
+func (o *Oracle) markTime(ts uint64) {
+	now := time.Now()
+	o.Lock()
+	defer o.Unlock()
+	if n := len(o.timeMarks); n > 0 &&
+		o.timeMarks[n-1].at.Truncate(time.Second).Equal(now.Truncate(time.Second)) {
+		o.timeMarks[n-1] = timeMark{at: now, ts: ts}
+		return
+	}
+	if len(o.timeMarks) >= maxTimeMarks {
+		o.timeMarks = append(o.timeMarks[:0:0], o.timeMarks[maxTimeMarks/4:]...)
+	}
+	o.timeMarks = append(o.timeMarks, timeMark{at: now, ts: ts})
+}
+
+// timestampAt returns the highest timestamp handed out by time t, accurate to a second. It
+// returns false if t is older than the oldest mark.
+func (o *Oracle) timestampAt(t time.Time) (uint64, bool) {
+	o.RLock()
+	defer o.RUnlock()
+	i := sort.Search(len(o.timeMarks), func(i int) bool {
+		return o.timeMarks[i].at.After(t)
+	})
+	if i == 0 {
+		return 0, false
+	}
+	return o.timeMarks[i-1].ts, true
+}
+
+// lastTimeMark returns the latest time mark, or nil if there's none.
+func (o *Oracle) lastTimeMark() *intern.TimeMark {
+	o.RLock()
+	defer o.RUnlock()
+	if len(o.timeMarks) == 0 {
+		return nil
+	}
+	m := o.timeMarks[len(o.timeMarks)-1]
+	return &intern.TimeMark{UnixNano: m.at.UnixNano(), Ts: m.ts}
+}
+
+// appendTimeMark adds mark to the marks kept in the membership state. Marks which aren't newer
+// than the last one are ignored, as they can be applied again after a restart.
+func appendTimeMark(marks []*intern.TimeMark, mark *intern.TimeMark) []*intern.TimeMark {
+	if n := len(marks); n > 0 &&
+		(mark.UnixNano <= marks[n-1].UnixNano || mark.Ts < marks[n-1].Ts) {
+		return marks
+	}
+	if len(marks) >= maxStateTimeMarks {
+		half := len(marks) / 2
+		thinned := make([]*intern.TimeMark, 0, maxStateTimeMarks)
+		for i := 0; i < half; i += 2 {
+			thinned = append(thinned, marks[i])
+		}
+		marks = append(thinned, marks[half:]...)
+	}
+	return append(marks, mark)
+}
+
+// timestampAt returns the highest timestamp handed out by time t. Times since this Zero became
+// leader are mapped accurate to a second, older ones accurate to the marks kept in the
+// membership state.
+func (s *Server) timestampAt(t time.Time) (uint64, error) {
+	if ts, ok := s.orc.timestampAt(t); ok {
+		return ts, nil
+	}
+	s.RLock()
+	defer s.RUnlock()
+	marks := s.state.TimeMarks
+	i := sort.Search(len(marks), func(i int) bool {
+		return marks[i].UnixNano > t.UnixNano()
+	})
+	if i == 0 {
+		if len(marks) == 0 {
+			return 0, x.Errorf("No timestamp is known for time %v. Zero hasn't mapped any times"+
+				" to timestamps yet", t.Format(time.RFC3339))
+		}
+		return 0, x.Errorf("No timestamp is known for time %v. The oldest time mapped by Zero"+
+			" is %v", t.Format(time.RFC3339), time.Unix(0, marks[0].UnixNano).Format(time.RFC3339))
+	}
+	return marks[i-1].Ts, nil
+}
+
+// proposeTimeMarks keeps the latest time mark of the leader in the membership state, so that
+// times can still be mapped to timestamps after a restart or a change of leader.
+func (s *Server) proposeTimeMarks() {
+	ticker := time.NewTicker(timeMarkInterval)
+	defer ticker.Stop()
+
+	var last int64
+	for range ticker.C {
+		if !s.Node.AmLeader() {
+			continue
+		}
+		mark := s.orc.lastTimeMark()
+		if mark == nil || mark.UnixNano == last {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := s.Node.proposeAndWait(ctx, &intern.ZeroProposal{TimeMark: mark})
+		cancel()
+		if err != nil {
+			x.Printf("Error while proposing time mark: %v\n", err)
+			continue
+		}
+		last = mark.UnixNano
+	}
+}
+
 func (o *Oracle) MaxPending() uint64 {
 	o.RLock()
 	defer o.RUnlock()
@@ -453,6 +583,22 @@ func (s *Server) TryAbort(ctx context.Context, txns *intern.TxnTimestamps) (*int
 	return commitTimestamps, nil
 }
 
+// TimestampAt returns the highest timestamp handed out by the given time, so that reading at it
+// sees the transactions committed by then.
+func (s *Server) TimestampAt(ctx context.Context, t *intern.Time) (*intern.Num, error) {
+	if ctx.Err() != nil {
+		return &intern.Num{}, ctx.Err()
+	}
+	if !s.Node.AmLeader() {
+		return &intern.Num{}, x.Errorf("Mapping times to timestamps is only allowed on leader.")
+	}
+	ts, err := s.timestampAt(time.Unix(0, t.UnixNano))
+	if err != nil {
+		return &intern.Num{}, err
+	}
+	return &intern.Num{Val: ts}, nil
+}
+
 // Timestamps is used to assign startTs for a new transaction
 func (s *Server) Timestamps(ctx context.Context, num *intern.Num) (*api.AssignedIds, error) {
 	if ctx.Err() != nil {
@@ -363,6 +363,9 @@ func (n *node) applyProposal(e raftpb.Entry) (uint32, error) {
 		x.Printf("Could not apply proposal, ignoring: p.MaxLeaseId=%v, p.MaxTxnTs=%v maxLeaseId=%d"+
 			" maxTxnTs=%d\n", p.MaxLeaseId, p.MaxTxnTs, state.MaxLeaseId, state.MaxTxnTs)
 	}
+	if p.TimeMark != nil {
+		state.TimeMarks = appendTimeMark(state.TimeMarks, p.TimeMark)
+	}
 	if p.Txn != nil {
 		n.server.orc.updateCommitStatus(e.Index, p.Txn)
 	}
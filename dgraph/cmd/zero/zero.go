@@ -75,6 +75,7 @@ func (s *Server) Init() {
 	s.shutDownCh = make(chan struct{}, 1)
 	go s.rebalanceTablets()
 	go s.purgeOracle()
+	go s.proposeTimeMarks()
 }
 
 func (s *Server) triggerLeaderChange() {
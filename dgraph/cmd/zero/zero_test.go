@@ -10,6 +10,7 @@ package zero
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/stretchr/testify/require"
@@ -28,3 +29,74 @@ func TestRemoveNode(t *testing.T) {
 	err = server.removeNode(nil, 1, 2)
 	require.Error(t, err)
 }
+
+func TestTimestampAt(t *testing.T) {
+	var o Oracle
+	_, ok := o.timestampAt(time.Now())
+	require.False(t, ok)
+
+	start := time.Now()
+	o.markTime(10)
+	o.markTime(20)
+	ts, ok := o.timestampAt(time.Now())
+	require.True(t, ok)
+	require.Equal(t, uint64(20), ts)
+
+	_, ok = o.timestampAt(start.Add(-time.Second))
+	require.False(t, ok)
+}
+
+func TestUpdateLeasesMarksTime(t *testing.T) {
+	server := &Server{
+		state: &intern.MembershipState{MaxTxnTs: 30000},
+		orc:   &Oracle{},
+	}
+	server.updateLeases()
+	// A new leader maps times from when it became leader to the timestamps leased before.
+	ts, err := server.timestampAt(time.Now())
+	require.NoError(t, err)
+	require.Equal(t, uint64(30000), ts)
+	_, err = server.timestampAt(time.Now().Add(-time.Minute))
+	require.Error(t, err)
+}
+
+func TestTimestampAtFromState(t *testing.T) {
+	now := time.Now()
+	server := &Server{
+		state: &intern.MembershipState{MaxTxnTs: 30000},
+		orc:   &Oracle{},
+	}
+	// Marks kept by the previous leaders.
+	for i, ts := range []uint64{100, 200, 300} {
+		mark := &intern.TimeMark{UnixNano: now.Add(time.Duration(i-3) * time.Hour).UnixNano(), Ts: ts}
+		server.state.TimeMarks = appendTimeMark(server.state.TimeMarks, mark)
+	}
+	// Applied again after a restart.
+	server.state.TimeMarks = appendTimeMark(server.state.TimeMarks, server.state.TimeMarks[1])
+	require.Len(t, server.state.TimeMarks, 3)
+	server.updateLeases()
+
+	ts, err := server.timestampAt(now.Add(-150 * time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), ts)
+	ts, err = server.timestampAt(now.Add(-30 * time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), ts)
+	ts, err = server.timestampAt(time.Now())
+	require.NoError(t, err)
+	require.Equal(t, uint64(30000), ts)
+	_, err = server.timestampAt(now.Add(-4 * time.Hour))
+	require.Error(t, err)
+}
+
+func TestAppendTimeMarkThins(t *testing.T) {
+	var marks []*intern.TimeMark
+	for i := 1; i <= maxStateTimeMarks+1; i++ {
+		marks = appendTimeMark(marks, &intern.TimeMark{UnixNano: int64(i), Ts: uint64(i)})
+	}
+	require.Len(t, marks, maxStateTimeMarks*3/4+1)
+	// The oldest mark is kept, every other one of the older half is dropped.
+	require.Equal(t, uint64(1), marks[0].Ts)
+	require.Equal(t, uint64(3), marks[1].Ts)
+	require.Equal(t, uint64(maxStateTimeMarks+1), marks[len(marks)-1].Ts)
+}
@@ -15,6 +15,7 @@ import (
 	"net"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/worker"
@@ -38,6 +39,8 @@ type Options struct {
 	RaftId              uint64
 	MaxPendingCount     uint64
 	ExpandEdge          bool
+	ChangeLogSize       int
+	HistoryRetention    time.Duration
 
 	DebugMode bool
 }
@@ -62,6 +65,7 @@ var DefaultConfig = Options{
 	ZeroAddr:            fmt.Sprintf("localhost:%d", x.PortZeroGrpc),
 	MaxPendingCount:     100,
 	ExpandEdge:          true,
+	ChangeLogSize:       100000,
 
 	DebugMode: false,
 }
@@ -123,6 +127,12 @@ func SetConfiguration(newConfig Options) {
 	worker.Config.ZeroAddr = Config.ZeroAddr
 	worker.Config.RaftId = Config.RaftId
 	worker.Config.ExpandEdge = Config.ExpandEdge
+	worker.Config.ChangeLogSize = Config.ChangeLogSize
+	worker.Config.HistoryRetention = Config.HistoryRetention
+	if Config.HistoryRetention > 0 {
+		// Nothing may be discarded until the start of the retention window is known.
+		posting.SetHistoryTs(0)
+	}
 
 	ips, err := parseIPsFromString(Config.WhitelistedIPs)
 
@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
@@ -203,6 +204,9 @@ func mapToNquads(m map[string]interface{}, idx *int, op int, parentPred string)
 				uid = 0
 			} else if ok := strings.HasPrefix(id, "_:"); ok {
 				mr.uid = id
+			} else if _, ok := gql.UidVar(id); ok {
+				// uid(v) refers to a variable of the upsert query.
+				mr.uid = id
 			} else if u, err := strconv.ParseUint(id, 0, 64); err != nil {
 				return mr, err
 			} else {
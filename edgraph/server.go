@@ -9,15 +9,19 @@ package edgraph
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math"
 	"math/rand"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"golang.org/x/net/context"
@@ -28,6 +32,7 @@ import (
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgo/y"
 	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/rdf"
@@ -56,6 +61,21 @@ type ServerState struct {
 // TODO(tzdybal) - remove global
 var State ServerState
 
+// ctxKey is the type of the keys under which the HTTP handlers attach the params of a request to
+// its context. gRPC clients pass the same params as metadata named after the key.
+type ctxKey string
+
+const (
+	UpsertKey        ctxKey = "upsert"         // query of an upsert block
+	UpsertIfKey      ctxKey = "upsert-if"      // condition of the mutation of an upsert block
+	UpsertAppliedKey ctxKey = "upsert-applied" // *bool set to whether the mutation was applied
+	TimeoutKey       ctxKey = "timeout"        // timeout of the query, as a duration
+	MemoryBudgetKey  ctxKey = "memory-budget"  // memory budget of the query, in bytes
+	ReadTsKey        ctxKey = "read-ts"        // timestamp a point-in-time query reads at
+	AsOfKey          ctxKey = "as-of"          // RFC3339 time a point-in-time query reads at
+	ExplainKey       ctxKey = "explain"        // *[]*query.ExplainNode set to the statistics
+)
+
 func InitServerState() {
 	Config.validate()
 
@@ -246,13 +266,14 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		_, err = query.ApplyMutations(ctx, m)
 		return empty, err
 	}
-	updates, err := schema.Parse(op.Schema)
+	updates, types, err := schema.ParseWithTypes(op.Schema)
 	if err != nil {
 		return empty, err
 	}
-	x.Printf("Got schema: %+v\n", updates)
+	x.Printf("Got schema: %+v, types: %+v\n", updates, types)
 	// TODO: Maybe add some checks about the schema.
 	m.Schema = updates
+	m.Types = types
 	_, err = query.ApplyMutations(ctx, m)
 	return empty, err
 }
@@ -291,6 +312,16 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 	if err != nil {
 		return resp, err
 	}
+	applied := true
+	if q := requestParam(ctx, UpsertKey); len(q) > 0 {
+		cond := requestParam(ctx, UpsertIfKey)
+		if applied, err = doQueryInUpsert(ctx, q, cond, mu.StartTs, gmu, &l); err != nil {
+			return resp, err
+		}
+		if len(cond) > 0 {
+			setUpsertApplied(ctx, applied)
+		}
+	}
 	parseEnd := time.Now()
 	l.Parsing = parseEnd.Sub(l.Start)
 	defer func() {
@@ -300,6 +331,12 @@ func (s *Server) Mutate(ctx context.Context, mu *api.Mutation) (resp *api.Assign
 			ProcessingNs: uint64(l.Processing.Nanoseconds()),
 		}
 	}()
+	if !applied {
+		// The condition of the upsert block doesn't hold, so the mutation is skipped. The
+		// transaction stays valid for any further operations.
+		resp.Context = &api.TxnContext{StartTs: mu.StartTs}
+		return resp, nil
+	}
 
 	newUids, err := query.AssignUids(ctx, gmu.Set)
 	if err != nil {
@@ -395,6 +432,21 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 	if Config.DebugMode {
 		x.Printf("Received query: %+v\n", req.Query)
 	}
+	var sgl []*query.SubGraph
+	if resp, sgl, err = processQuery(ctx, req); err != nil {
+		return resp, err
+	}
+	if explainRequested(ctx) {
+		setExplain(ctx, query.Explain(sgl))
+	}
+	return resp, nil
+}
+
+// processQuery executes the query of the request at its start timestamp, or at a new one if it
+// has none. It returns the response along with the SubGraphs executed.
+func processQuery(ctx context.Context, req *api.Request) (*api.Response, []*query.SubGraph,
+	error) {
+	resp := new(api.Response)
 	var l query.Latency
 	l.Start = time.Now()
 	if tr, ok := trace.FromContext(ctx); ok {
@@ -406,14 +458,27 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 		Variables: req.Vars,
 	})
 	if err != nil {
-		return resp, err
+		return resp, nil, err
 	}
 
-	if req.StartTs == 0 {
-		req.StartTs = State.getTimestamp()
+	readTs, err := readTsParam(ctx)
+	if err != nil {
+		return resp, nil, err
 	}
-	resp.Txn = &api.TxnContext{
-		StartTs: req.StartTs,
+	if readTs > 0 {
+		if req.StartTs != 0 {
+			return resp, nil, x.Errorf("A point-in-time query can't be part of a transaction")
+		}
+		// The timestamp isn't returned, so that it can't be used to start a transaction.
+		req.StartTs = readTs
+		resp.Txn = &api.TxnContext{}
+	} else {
+		if req.StartTs == 0 {
+			req.StartTs = State.getTimestamp()
+		}
+		resp.Txn = &api.TxnContext{
+			StartTs: req.StartTs,
+		}
 	}
 
 	var queryRequest = query.QueryRequest{
@@ -422,13 +487,16 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 		ReadTs:   req.StartTs,
 		LinRead:  req.LinRead,
 	}
+	if err := setQueryLimits(ctx, &queryRequest); err != nil {
+		return resp, nil, err
+	}
 
 	var er query.ExecuteResult
 	if er, err = queryRequest.Process(ctx); err != nil {
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Error while processing query: %+v", err)
 		}
-		return resp, x.Wrap(err)
+		return resp, nil, x.Wrap(err)
 	}
 	resp.Schema = er.SchemaNode
 
@@ -437,7 +505,7 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 		if tr, ok := trace.FromContext(ctx); ok {
 			tr.LazyPrintf("Error while converting to protocol buffer: %+v", err)
 		}
-		return resp, err
+		return resp, nil, err
 	}
 	resp.Json = json
 
@@ -449,7 +517,7 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (resp *api.Respons
 
 	resp.Latency = gl
 	resp.Txn.LinRead = queryRequest.LinRead
-	return resp, err
+	return resp, er.Subgraphs, nil
 }
 
 func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.TxnContext,
@@ -491,6 +559,161 @@ func (s *Server) CheckVersion(ctx context.Context, c *api.Check) (v *api.Version
 //-------------------------------------------------------------------------------------------------
 // HELPER FUNCTIONS
 //-------------------------------------------------------------------------------------------------
+// requestParam returns the value of key passed along with a request, like "upsert" for the query
+// of an upsert block and "upsert-if" for the condition of its mutation.
+func requestParam(ctx context.Context, key ctxKey) string {
+	// gRPC client passes the params as metadata.
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[string(key)]) > 0 {
+		return md[string(key)][0]
+	}
+	// HTTP handler attaches the params to the context.
+	v, _ := ctx.Value(key).(string)
+	return v
+}
+
+// setQueryLimits sets the timeout and the memory budget of a query. They default to the ones
+// of the server, but can be overridden by the "timeout" and "memory-budget" (in bytes) params.
+func setQueryLimits(ctx context.Context, req *query.QueryRequest) error {
+	req.Timeout = x.Config.QueryTimeout
+	req.MemoryBudget = x.Config.QueryMemoryBudget
+	if v := requestParam(ctx, TimeoutKey); len(v) > 0 {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return x.Errorf("Invalid query timeout: %q", v)
+		}
+		req.Timeout = d
+	}
+	if v := requestParam(ctx, MemoryBudgetKey); len(v) > 0 {
+		b, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return x.Errorf("Invalid query memory budget: %q", v)
+		}
+		req.MemoryBudget = b
+	}
+	return nil
+}
+
+// readTsParam returns the timestamp a point-in-time query reads at. It's given by the "read-ts"
+// param, or by the RFC3339 time in the "as-of" param, which Zero maps to a timestamp. It's zero
+// if neither is given.
+func readTsParam(ctx context.Context) (uint64, error) {
+	readTs, asOf := requestParam(ctx, ReadTsKey), requestParam(ctx, AsOfKey)
+	var ts uint64
+	switch {
+	case len(readTs) > 0 && len(asOf) > 0:
+		return 0, x.Errorf("Only one of read timestamp and as-of time can be given")
+	case len(readTs) > 0:
+		var err error
+		if ts, err = strconv.ParseUint(readTs, 10, 64); err != nil || ts == 0 {
+			return 0, x.Errorf("Invalid read timestamp: %q", readTs)
+		}
+	case len(asOf) > 0:
+		t, err := time.Parse(time.RFC3339, asOf)
+		if err != nil {
+			return 0, x.Errorf("Invalid as-of time: %q. It must be in RFC3339 format", asOf)
+		}
+		if t.After(time.Now()) {
+			return 0, x.Errorf("As-of time %v is in the future", asOf)
+		}
+		if ts, err = worker.TimestampAt(ctx, t); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, nil
+	}
+	// Reading at a timestamp not handed out yet could miss transactions committed before it.
+	if max := posting.Oracle().MaxPending(); ts > max {
+		return 0, x.Errorf("Read timestamp %d is ahead of the latest one, %d", ts, max)
+	}
+	return ts, nil
+}
+
+// setUpsertApplied reports whether the mutation of a conditional upsert was applied. The HTTP
+// handler passes a *bool in the context and returns it as "applied" in the response data. gRPC
+// clients get it in the "upsert-applied" header instead. This is a stopgap until api.Assigned,
+// which comes from the client library, has a field for it.
+func setUpsertApplied(ctx context.Context, applied bool) {
+	if res, ok := ctx.Value(UpsertAppliedKey).(*bool); ok {
+		*res = applied
+		return
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(string(UpsertAppliedKey),
+		strconv.FormatBool(applied))); err != nil {
+		x.Printf("Error while setting upsert-applied header: %v\n", err)
+	}
+}
+
+// explainRequested tells whether the execution statistics of a query were asked for. gRPC
+// clients set the "explain" metadata to true, whereas the HTTP handler attaches a
+// *[]*query.ExplainNode to the context.
+func explainRequested(ctx context.Context) bool {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[string(ExplainKey)]) > 0 {
+		explain, _ := strconv.ParseBool(md[string(ExplainKey)][0])
+		return explain
+	}
+	_, ok := ctx.Value(ExplainKey).(*[]*query.ExplainNode)
+	return ok
+}
+
+// setExplain returns the execution statistics of a query. gRPC clients get them as JSON in the
+// "explain" header.
+func setExplain(ctx context.Context, nodes []*query.ExplainNode) {
+	if res, ok := ctx.Value(ExplainKey).(*[]*query.ExplainNode); ok {
+		*res = nodes
+		return
+	}
+	js, err := json.Marshal(nodes)
+	if err != nil {
+		x.Printf("Error while marshalling explain: %v\n", err)
+		return
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(string(ExplainKey), string(js))); err != nil {
+		x.Printf("Error while setting explain header: %v\n", err)
+	}
+}
+
+// doQueryInUpsert executes the query of an upsert block at the start timestamp of the mutation,
+// so that it is part of the same transaction. If the mutation has a condition, it's evaluated on
+// the variables defined by the query and false is returned if it doesn't hold. Otherwise, the
+// variables are substituted in the N-Quads of the mutation.
+func doQueryInUpsert(ctx context.Context, q, cond string, startTs uint64, gmu *gql.Mutation,
+	l *query.Latency) (bool, error) {
+	needVars := gmu.NeedVars()
+	var condTree *gql.FilterTree
+	if len(cond) > 0 {
+		var err error
+		if condTree, err = gql.ParseCond(cond); err != nil {
+			return false, err
+		}
+		needVars = x.RemoveDuplicates(append(needVars, condTree.NeedVars()...))
+	}
+
+	parsedReq, err := gql.ParseWithNeedVars(gql.Request{Str: q}, needVars)
+	if err != nil {
+		return false, err
+	}
+	if parsedReq.Schema != nil {
+		return false, x.Errorf("Schema block is not allowed in upsert block")
+	}
+	queryRequest := query.QueryRequest{
+		Latency:  l,
+		GqlQuery: &parsedReq,
+		ReadTs:   startTs,
+	}
+	if err := setQueryLimits(ctx, &queryRequest); err != nil {
+		return false, err
+	}
+	if err := queryRequest.ProcessQuery(ctx); err != nil {
+		return false, x.Wrapf(err, "While processing query in upsert block")
+	}
+	if condTree != nil {
+		if ok, err := queryRequest.EvalCond(condTree); err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, queryRequest.ExpandVarsInMutation(gmu)
+}
+
 func isMutationAllowed(ctx context.Context) bool {
 	if !Config.Nomutations {
 		return true
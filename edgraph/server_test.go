@@ -12,7 +12,11 @@ import (
 	"testing"
 	"time"
 
+	"golang.org/x/net/context"
+
 	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
@@ -328,3 +332,54 @@ func TestParseNQuadsDelete(t *testing.T) {
 		makeNquad("_:a", x.Star, &api.Value{&api.Value_DefaultVal{x.Star}}),
 	}, nqs)
 }
+
+func TestSetQueryLimits(t *testing.T) {
+	x.Config.QueryTimeout = time.Minute
+	x.Config.QueryMemoryBudget = 1 << 20
+	defer func() {
+		x.Config.QueryTimeout = 0
+		x.Config.QueryMemoryBudget = 0
+	}()
+
+	var req query.QueryRequest
+	require.NoError(t, setQueryLimits(context.Background(), &req))
+	require.Equal(t, time.Minute, req.Timeout)
+	require.Equal(t, uint64(1<<20), req.MemoryBudget)
+
+	ctx := context.WithValue(context.Background(), TimeoutKey, "5s")
+	ctx = context.WithValue(ctx, MemoryBudgetKey, "1024")
+	require.NoError(t, setQueryLimits(ctx, &req))
+	require.Equal(t, 5*time.Second, req.Timeout)
+	require.Equal(t, uint64(1024), req.MemoryBudget)
+
+	ctx = context.WithValue(context.Background(), TimeoutKey, "soon")
+	require.Error(t, setQueryLimits(ctx, &req))
+}
+
+func TestReadTsParam(t *testing.T) {
+	posting.Oracle().SetMaxPending(100)
+
+	ts, err := readTsParam(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, ts)
+
+	ctx := context.WithValue(context.Background(), ReadTsKey, "42")
+	ts, err = readTsParam(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), ts)
+
+	for _, params := range []map[ctxKey]string{
+		{ReadTsKey: "0"},
+		{ReadTsKey: "101"},
+		{ReadTsKey: "42", AsOfKey: "2018-05-01T00:00:00Z"},
+		{AsOfKey: "yesterday"},
+		{AsOfKey: time.Now().Add(time.Hour).Format(time.RFC3339)},
+	} {
+		ctx := context.Background()
+		for k, v := range params {
+			ctx = context.WithValue(ctx, k, v)
+		}
+		_, err := readTsParam(ctx)
+		require.Error(t, err, "%v", params)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package edgraph
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/trace"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Subscribe sends the result of the query to the client, and then a new one each time it
+// changes. See Watch.
+func (s *Server) Subscribe(req *api.Request, stream intern.Subscriptions_SubscribeServer) error {
+	return s.Watch(stream.Context(), req, stream.Send)
+}
+
+// Watch executes the query of the request and calls send with its result. The query is executed
+// again whenever a transaction writing to any of its predicates commits, and send is called
+// with the new result if it changed. It returns once the context is done or send fails.
+func (s *Server) Watch(ctx context.Context, req *api.Request,
+	send func(*api.Response) error) error {
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	if len(req.Query) == 0 {
+		return fmt.Errorf("empty query")
+	}
+	if tr, ok := trace.FromContext(ctx); ok {
+		tr.LazyPrintf("Subscription received: %v", req.Query)
+	}
+
+	return watch(ctx, req, func(ctx context.Context, req *api.Request) (*api.Response, []string,
+		error) {
+		resp, sgl, err := processQuery(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp, query.GetAllPredicates(sgl), nil
+	}, send)
+}
+
+// watch calls send with the result of run, and runs it again whenever a transaction writing to
+// any of the predicates it returned commits. send is only called if the result changed.
+func watch(ctx context.Context, req *api.Request,
+	run func(context.Context, *api.Request) (*api.Response, []string, error),
+	send func(*api.Response) error) error {
+	// Commits are noticed while the query is executed, so it's executed again if it might have
+	// missed them. Until the query has been executed, its predicates aren't known.
+	var mu sync.Mutex
+	var preds []string
+	known := false
+	changed := make(chan struct{}, 1)
+	stop := posting.Oracle().WatchCommits(func(e posting.CommitEvent) {
+		mu.Lock()
+		touched := !known || e.Touches(preds)
+		mu.Unlock()
+		if touched {
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		}
+	})
+	defer stop()
+
+	var last []byte
+	for {
+		// Every execution reads at a new timestamp, to see the latest commits.
+		r := *req
+		r.StartTs = 0
+		resp, respPreds, err := run(ctx, &r)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		preds, known = respPreds, true
+		mu.Unlock()
+
+		if last == nil || !bytes.Equal(resp.Json, last) {
+			if err := send(resp); err != nil {
+				return err
+			}
+			last = resp.Json
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+		}
+	}
+}
+
+// Changes streams the edges committed on this server after the commit timestamp of the
+// request. See worker.StreamChanges.
+func (s *Server) Changes(req *intern.ChangesRequest, stream intern.Subscriptions_ChangesServer) error {
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	return worker.StreamChanges(stream.Context(), req, stream.Send)
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package edgraph
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// commitTo commits a transaction writing to the predicate, as if Zero had sent its commit.
+func commitTo(pred string, startTs uint64) {
+	txn := posting.Txns().PutOrMergeIndex(&posting.Txn{StartTs: startTs, Indices: []uint64{1}})
+	txn.AddDelta(x.DataKey(pred, 1), &intern.Posting{}, false)
+	posting.Oracle().ProcessOracleDelta(&intern.OracleDelta{
+		Commits: map[uint64]uint64{startTs: startTs + 1},
+	})
+}
+
+func TestWatch(t *testing.T) {
+	// Every run returns a different result, so that every run is sent.
+	var runs int
+	run := func(ctx context.Context, req *api.Request) (*api.Response, []string, error) {
+		runs++
+		return &api.Response{Json: []byte(fmt.Sprintf(`{"runs":%d}`, runs))},
+			[]string{"name"}, nil
+	}
+	sent := make(chan string, 10)
+	send := func(resp *api.Response) error {
+		sent <- string(resp.Json)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watch(ctx, &api.Request{Query: "{ me(func: uid(1)) { name } }"}, run, send)
+	}()
+	require.Equal(t, `{"runs":1}`, <-sent)
+
+	// A commit which doesn't touch name doesn't run the query again.
+	commitTo("friend", 500)
+	select {
+	case resp := <-sent:
+		t.Fatalf("Query ran again after a commit to another predicate: %s", resp)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	commitTo("name", 510)
+	select {
+	case resp := <-sent:
+		require.Equal(t, `{"runs":2}`, resp)
+	case <-time.After(time.Second):
+		t.Fatal("Query didn't run again after a commit to its predicate")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+}
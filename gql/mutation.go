@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/protos/intern"
@@ -36,6 +37,46 @@ func (m Mutation) HasOps() bool {
 	return len(m.Set) > 0 || len(m.Del) > 0 || len(m.Schema) > 0 || m.DropAll
 }
 
+// NeedVars returns the names of the query variables referenced through uid() and val() in the
+// N-Quads of the mutation.
+func (m Mutation) NeedVars() []string {
+	var vars []string
+	for _, nqs := range [][]*api.NQuad{m.Set, m.Del} {
+		for _, nq := range nqs {
+			if v, ok := UidVar(nq.Subject); ok {
+				vars = append(vars, v)
+			}
+			if v, ok := UidVar(nq.ObjectId); ok {
+				vars = append(vars, v)
+			}
+			if v, ok := ValVar(nq.ObjectId); ok {
+				vars = append(vars, v)
+			}
+		}
+	}
+	return x.RemoveDuplicates(vars)
+}
+
+// UidVar returns the name of the variable if str is of the form uid(v).
+func UidVar(str string) (string, bool) {
+	return varName(str, uid)
+}
+
+// ValVar returns the name of the variable if str is of the form val(v). Value variables are
+// referenced in place of the object id, so quoted values are never taken for them.
+func ValVar(str string) (string, bool) {
+	return varName(str, value)
+}
+
+func varName(str, fn string) (string, bool) {
+	str = strings.TrimSpace(str)
+	if !strings.HasPrefix(str, fn+"(") || !strings.HasSuffix(str, ")") {
+		return "", false
+	}
+	name := strings.TrimSpace(str[len(fn)+1 : len(str)-1])
+	return name, len(name) > 0
+}
+
 // Gets the uid corresponding
 func ParseUid(xid string) (uint64, error) {
 	// If string represents a UID, convert to uint64 and return.
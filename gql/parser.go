@@ -9,6 +9,7 @@ package gql
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/dgraph-io/dgraph/lex"
 	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -41,21 +43,34 @@ type GraphQuery struct {
 
 	Args map[string]string
 	// Query can have multiple sort parameters.
-	Order        []*intern.Order
-	Children     []*GraphQuery
-	Filter       *FilterTree
-	MathExp      *MathTree
-	Normalize    bool
-	Recurse      bool
-	RecurseArgs  RecurseArgs
-	Cascade      bool
-	IgnoreReflex bool
-	Facets       *intern.FacetParams
-	FacetsFilter *FilterTree
-	GroupbyAttrs []GroupByAttr
-	FacetVar     map[string]string
-	FacetOrder   string
-	FacetDesc    bool
+	Order         []*intern.Order
+	Children      []*GraphQuery
+	Filter        *FilterTree
+	MathExp       *MathTree
+	Normalize     bool
+	Recurse       bool
+	RecurseArgs   RecurseArgs
+	Cascade       bool
+	IgnoreReflex  bool
+	Highlight     bool
+	Facets        *intern.FacetParams
+	FacetsFilter  *FilterTree
+	GroupbyAttrs  []GroupByAttr
+	GroupbyOrder  []GroupByOrder
+	GroupbyFirst  int
+	GroupbyOffset int
+	Having        *FilterTree
+	FacetVar      map[string]string
+	FacetOrder    string
+	FacetDesc     bool
+
+	// Children required by @cascade(name, email). All of them are if empty.
+	CascadeFields []string
+
+	// Conditions of @include(if: ...) and @skip(if: ...), which are booleans once the GraphQL
+	// variables are substituted.
+	IncludeIf string
+	SkipIf    string
 
 	// Internal fields below.
 	// If gq.fragment is nonempty, then it is a fragment reference / spread.
@@ -83,6 +98,20 @@ type GroupByAttr struct {
 	Langs []string
 }
 
+// GroupAggregate is an aggregate of the members of a group formed by @groupby, like
+// count(uid) or avg(age), by which the groups are filtered in @having and ordered.
+type GroupAggregate struct {
+	Name  string // count for count(uid).
+	Attr  string
+	Langs []string
+	Args  []Arg // The percentile of percentile().
+}
+
+type GroupByOrder struct {
+	Aggregate *GroupAggregate
+	Desc      bool
+}
+
 // pair denotes the key value pair that is part of the GraphQL query root in parenthesis.
 type pair struct {
 	Key string
@@ -116,6 +145,8 @@ type VarContext struct {
 type varInfo struct {
 	Value string
 	Type  string
+	// Elements of the value of a list-typed variable, like [uid] or [string].
+	List []string
 }
 
 // varMap is a map with key as GQL variable name.
@@ -143,9 +174,12 @@ type Function struct {
 	Name       string // Specifies the name of the function.
 	Args       []Arg  // Contains the arguments of the function.
 	UID        []uint64
-	NeedsVar   []VarContext // If the function requires some variable
-	IsCount    bool         // gt(count(friends),0)
-	IsValueVar bool         // eq(val(s), 5)
+	NeedsVar   []VarContext    // If the function requires some variable
+	IsCount    bool            // gt(count(friends),0)
+	IsValueVar bool            // eq(val(s), 5)
+	IsLenVar   bool            // eq(len(s), 0)
+	Aggregate  *GroupAggregate // gt(count(uid), 10) in @having
+	Filter     *FilterTree     // exists(orders @filter(gt(amount, 100)))
 }
 
 // filterOpPrecedence is a map from filterOp (a string) to its precedence.
@@ -188,6 +222,18 @@ func init() {
 
 }
 
+// Skipped returns whether the block is left out of the query by @include or @skip.
+func (gq *GraphQuery) Skipped() bool {
+	include, skip := true, false
+	if gq.IncludeIf != "" {
+		include, _ = strconv.ParseBool(gq.IncludeIf)
+	}
+	if gq.SkipIf != "" {
+		skip, _ = strconv.ParseBool(gq.SkipIf)
+	}
+	return !include || skip
+}
+
 func (f *Function) IsAggregator() bool {
 	return isAggregator(f.Name)
 }
@@ -292,37 +338,140 @@ func checkValueType(vm varMap) error {
 			typ = typ[:len(typ)-1]
 		}
 
-		// Type check the values.
-		if v.Value != "" {
-			switch typ {
-			case "int":
-				{
-					if _, err := strconv.ParseInt(v.Value, 0, 64); err != nil {
-						return x.Wrapf(err, "Expected an int but got %v", v.Value)
-					}
-				}
-			case "float":
-				{
-					if _, err := strconv.ParseFloat(v.Value, 64); err != nil {
-						return x.Wrapf(err, "Expected a float but got %v", v.Value)
-					}
-				}
-			case "bool":
-				{
-					if _, err := strconv.ParseBool(v.Value); err != nil {
-						return x.Wrapf(err, "Expected a bool but got %v", v.Value)
-					}
+		if !isListType(typ) {
+			// Type check the values.
+			if v.Value != "" {
+				if err := checkScalarType(typ, v.Value); err != nil {
+					return err
 				}
-			case "string": // Value is a valid string. No checks required.
-			default:
-				return x.Errorf("Type %v not supported", typ)
 			}
+			continue
 		}
+
+		// The value of a list is a JSON array, e.g. ["0x1", "0x2"] for a [uid].
+		typ = typ[1 : len(typ)-1]
+		if typ == "geo" {
+			return x.Errorf("Type [%v] not supported", typ)
+		}
+		if err := checkScalarType(typ, ""); err != nil {
+			return err
+		}
+		list, err := parseListValue(v.Value)
+		if err != nil {
+			return x.Wrapf(err, "Expected a list of %v for variable %v", typ, k)
+		}
+		for _, elem := range list {
+			if err := checkScalarType(typ, elem); err != nil {
+				return x.Wrapf(err, "Invalid element in list variable %v", k)
+			}
+		}
+		v.List = list
+		if typ == uid {
+			// So that uid($ids) parses it like a list of uids written in the query.
+			v.Value = "[" + strings.Join(list, ", ") + "]"
+		}
+		vm[k] = v
 	}
 
 	return nil
 }
 
+func isListType(typ string) bool {
+	return len(typ) > 2 && typ[0] == '[' && typ[len(typ)-1] == ']'
+}
+
+// checkScalarType checks that val is a valid value of a variable of type typ. An empty val only
+// checks that the type is supported.
+func checkScalarType(typ, val string) error {
+	switch typ {
+	case "int":
+		if _, err := strconv.ParseInt(val, 0, 64); val != "" && err != nil {
+			return x.Wrapf(err, "Expected an int but got %v", val)
+		}
+	case "float":
+		if _, err := strconv.ParseFloat(val, 64); val != "" && err != nil {
+			return x.Wrapf(err, "Expected a float but got %v", val)
+		}
+	case "bool":
+		if _, err := strconv.ParseBool(val); val != "" && err != nil {
+			return x.Wrapf(err, "Expected a bool but got %v", val)
+		}
+	case uid:
+		if _, err := strconv.ParseUint(val, 0, 64); val != "" && err != nil {
+			return x.Wrapf(err, "Expected a uid but got %v", val)
+		}
+	case "datetime":
+		if _, err := types.ParseTime(val); val != "" && err != nil {
+			return x.Wrapf(err, "Expected a datetime but got %v", val)
+		}
+	case "geo":
+		// Coordinates, as in near(loc, [-122.4, 37.7], 1000).
+		if val != "" && !isGeoCoords(val) {
+			return x.Errorf("Expected the coordinates of a point or polygon but got %v", val)
+		}
+	case "string": // Value is a valid string. No checks required.
+	default:
+		return x.Errorf("Type %v not supported", typ)
+	}
+	return nil
+}
+
+// parseListValue parses the JSON array given as the value of a list variable. Its elements can be
+// strings, numbers or booleans.
+func parseListValue(val string) ([]string, error) {
+	list := []string{}
+	if val == "" {
+		return list, nil
+	}
+	var elems []interface{}
+	dec := json.NewDecoder(strings.NewReader(val))
+	dec.UseNumber()
+	if err := dec.Decode(&elems); err != nil {
+		return nil, err
+	}
+	for _, elem := range elems {
+		switch e := elem.(type) {
+		case string:
+			list = append(list, e)
+		case json.Number:
+			list = append(list, e.String())
+		case bool:
+			list = append(list, strconv.FormatBool(e))
+		default:
+			return nil, x.Errorf("Unexpected element %v in list", elem)
+		}
+	}
+	return list, nil
+}
+
+func isGeoCoords(val string) bool {
+	var coords interface{}
+	if err := json.Unmarshal([]byte(val), &coords); err != nil {
+		return false
+	}
+	var check func(c interface{}, depth int) bool
+	check = func(c interface{}, depth int) bool {
+		list, ok := c.([]interface{})
+		if !ok || len(list) == 0 || depth > 4 {
+			return false
+		}
+		if _, ok := list[0].(float64); ok {
+			if len(list) != 2 {
+				return false
+			}
+			_, ok = list[1].(float64)
+			return ok
+		}
+		for _, elem := range list {
+			if !check(elem, depth+1) {
+				return false
+			}
+		}
+		return true
+	}
+	return check(coords, 1)
+}
+
 func substituteVar(f string, res *string, vmap varMap) error {
 	if len(f) > 0 && f[0] == '$' {
 		va, ok := vmap[f]
@@ -334,7 +483,27 @@ func substituteVar(f string, res *string, vmap varMap) error {
 	return nil
 }
 
+// substituteVarList is like substituteVar, but returns one value for every element of a list
+// variable, e.g. for eq(name, $names).
+func substituteVarList(f string, vmap varMap) ([]string, error) {
+	if va, ok := vmap[f]; ok && isListType(strings.TrimSuffix(va.Type, "!")) {
+		return va.List, nil
+	}
+	res := f
+	err := substituteVar(f, &res, vmap)
+	return []string{res}, err
+}
+
 func substituteVariables(gq *GraphQuery, vmap varMap) error {
+	for _, cond := range []*string{&gq.IncludeIf, &gq.SkipIf} {
+		if err := substituteVar(*cond, cond, vmap); err != nil {
+			return err
+		}
+		if _, err := strconv.ParseBool(*cond); *cond != "" && err != nil {
+			return x.Errorf("Expected a bool in the condition of @include or @skip, got: %q",
+				*cond)
+		}
+	}
 	for k, v := range gq.Args {
 		// v won't be empty as its handled in parseGqlVariables.
 		val := gq.Args[k]
@@ -363,27 +532,34 @@ func substituteVariables(gq *GraphQuery, vmap varMap) error {
 			return err
 		}
 
-		for idx, v := range gq.Func.Args {
+		var args []Arg
+		for _, v := range gq.Func.Args {
 			if !v.IsGraphQLVar {
+				args = append(args, v)
 				continue
 			}
-			if err := substituteVar(v.Value, &gq.Func.Args[idx].Value, vmap); err != nil {
+			vals, err := substituteVarList(v.Value, vmap)
+			if err != nil {
 				return err
 			}
-			if gq.Func.Name == "regexp" {
+			for _, val := range vals {
+				args = append(args, Arg{Value: val, IsGraphQLVar: true})
+			}
+			if gq.Func.Name == "regexp" && len(vals) == 1 {
 				// Value should have been populated from the map that the user gave us in the
 				// GraphQL variable map. Let's parse the expression and flags from the variable
 				// string.
-				ra, err := parseRegexArgs(gq.Func.Args[idx].Value)
+				ra, err := parseRegexArgs(vals[0])
 				if err != nil {
 					return err
 				}
 				// We modify the value of this arg and add a new arg for the flags. Regex functions
 				// should have two args.
-				gq.Func.Args[idx].Value = ra.expr
-				gq.Func.Args = append(gq.Func.Args, Arg{Value: ra.flags})
+				args[len(args)-1].Value = ra.expr
+				args = append(args, Arg{Value: ra.flags})
 			}
 		}
+		gq.Func.Args = args
 	}
 
 	for _, child := range gq.Children {
@@ -405,7 +581,8 @@ func substituteVariablesFilter(f *FilterTree, vmap varMap) error {
 			return err
 		}
 
-		for idx, v := range f.Func.Args {
+		var args []Arg
+		for _, v := range f.Func.Args {
 			if f.Func.Name == uid {
 				// This is to support GraphQL variables in uid functions.
 				idVal, ok := vmap[v.Value]
@@ -420,10 +597,21 @@ func substituteVariablesFilter(f *FilterTree, vmap varMap) error {
 					return err
 				}
 				f.Func.UID = append(f.Func.UID, uids...)
+				args = append(args, v)
 				continue
 			}
 
-			if err := substituteVar(v.Value, &f.Func.Args[idx].Value, vmap); err != nil {
+			vals, err := substituteVarList(v.Value, vmap)
+			if err != nil {
+				return err
+			}
+			for _, val := range vals {
+				args = append(args, Arg{Value: val, IsGraphQLVar: v.IsGraphQLVar})
+			}
+		}
+		f.Func.Args = args
+		if f.Func.Filter != nil {
+			if err := substituteVariablesFilter(f.Func.Filter, vmap); err != nil {
 				return err
 			}
 		}
@@ -455,6 +643,32 @@ type Result struct {
 // Parse initializes and runs the lexer. It also constructs the GraphQuery subgraph
 // from the lexed items.
 func Parse(r Request) (res Result, rerr error) {
+	return ParseWithNeedVars(r, nil)
+}
+
+// ParseWithNeedVars performs parsing of a query with given needVars.
+//
+// The needVars parameter is passed in the case of upsert block.
+// For example, when parsing the query block inside -
+//
+//	upsert {
+//	  query {
+//	    me(func: eq(email, "someone@gmail.com")) {
+//	        v as uid
+//	    }
+//	  }
+//
+//	  mutation {
+//	    set {
+//	      uid(v) <name> "Some One" .
+//	      uid(v) <email> "someone@gmail.com" .
+//	    }
+//	  }
+//	}
+//
+// The variable name v needs to be passed through the needVars parameter. Otherwise, an error
+// is reported complaining that the variable v is defined but not used in the query block.
+func ParseWithNeedVars(r Request, needVars []string) (res Result, rerr error) {
 	query := r.Str
 	vmap := convertToVarMap(r.Variables)
 
@@ -534,6 +748,9 @@ func Parse(r Request) (res Result, rerr error) {
 		}
 
 		allVars := res.QueryVars
+		// Add a dummy entry for the variables that are used outside the query (e.g. in the
+		// mutation of an upsert block), so that checkDependency doesn't complain about them.
+		allVars = append(allVars, &Vars{Needs: needVars})
 		if err := checkDependency(allVars); err != nil {
 			return res, err
 		}
@@ -623,16 +840,29 @@ func (f *FilterTree) collectVars(v *Vars) {
 		for _, va := range f.Func.NeedsVar {
 			v.Needs = append(v.Needs, va.Name)
 		}
+		if f.Func.Filter != nil {
+			f.Func.Filter.collectVars(v)
+		}
 	}
 	for _, fch := range f.Child {
 		fch.collectVars(v)
 	}
 }
 
+// NeedVars returns the names of the variables used in the filter tree.
+func (f *FilterTree) NeedVars() []string {
+	v := &Vars{}
+	f.collectVars(v)
+	return x.RemoveDuplicates(v.Needs)
+}
+
 func (f *FilterTree) hasVars() bool {
 	if (f.Func != nil) && (len(f.Func.NeedsVar) > 0) {
 		return true
 	}
+	if f.Func != nil && f.Func.Filter != nil && f.Func.Filter.hasVars() {
+		return true
+	}
 	for _, fch := range f.Child {
 		if fch.hasVars() {
 			return true
@@ -733,6 +963,71 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 	return nil
 }
 
+// parseCascade parses @cascade, and the fields it's restricted to in @cascade(name, email).
+func parseCascade(it *lex.ItemIterator, gq *GraphQuery) error {
+	if gq.Cascade {
+		return x.Errorf("Only one cascade directive allowed.")
+	}
+	gq.Cascade = true
+	if ok := trySkipItemTyp(it, itemLeftRound); !ok {
+		return nil
+	}
+	expectArg := true
+	for it.Next() {
+		item := it.Item()
+		switch {
+		case item.Typ == itemRightRound && !expectArg:
+			return nil
+		case item.Typ == itemComma && !expectArg:
+			expectArg = true
+		case item.Typ == itemName && expectArg:
+			gq.CascadeFields = append(gq.CascadeFields, collectName(it, item.Val))
+			expectArg = false
+		default:
+			return x.Errorf("Expected a list of fields in @cascade, got: %s", item.Val)
+		}
+	}
+	return x.Errorf("Expected ) after the fields of @cascade")
+}
+
+// parseCondition parses the condition of @include(if: $flag) and @skip(if: $flag): a boolean, or
+// a GraphQL variable substituted later.
+func parseCondition(it *lex.ItemIterator, gq *GraphQuery, directive string) error {
+	cond := &gq.IncludeIf
+	if directive == "skip" {
+		cond = &gq.SkipIf
+	}
+	if *cond != "" {
+		return x.Errorf("Only one %s directive allowed.", directive)
+	}
+	if ok := trySkipItemTyp(it, itemLeftRound); !ok {
+		return x.Errorf("Expected ( after %s", directive)
+	}
+	if item, ok := tryParseItemType(it, itemName); !ok || item.Val != "if" {
+		return x.Errorf("Expected if: inside @%s()", directive)
+	}
+	if ok := trySkipItemTyp(it, itemColon); !ok {
+		return x.Errorf("Expected colon(:) after if")
+	}
+	var val string
+	if trySkipItemTyp(it, itemDollar) {
+		val = "$"
+	}
+	item, ok := tryParseItemType(it, itemName)
+	if !ok {
+		return x.Errorf("Expected a bool or a variable inside @%s()", directive)
+	}
+	val += item.Val
+	if _, err := strconv.ParseBool(val); val[0] != '$' && err != nil {
+		return x.Errorf("Expected a bool inside @%s(), got: %s", directive, val)
+	}
+	if ok := trySkipItemTyp(it, itemRightRound); !ok {
+		return x.Errorf("Expected ) after the condition of @%s", directive)
+	}
+	*cond = val
+	return nil
+}
+
 // getQuery creates a GraphQuery object tree by calling getRoot
 // and goDeep functions by looking at '{'.
 func getQuery(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
@@ -773,12 +1068,29 @@ L:
 			case "normalize":
 				gq.Normalize = true
 			case "cascade":
-				gq.Cascade = true
+				if err := parseCascade(it, gq); err != nil {
+					return nil, err
+				}
 			case "groupby":
 				gq.IsGroupby = true
-				parseGroupby(it, gq)
+				if err := parseGroupby(it, gq); err != nil {
+					return nil, err
+				}
+			case "having":
+				if gq.Having != nil {
+					return nil, x.Errorf("Only one having directive allowed.")
+				}
+				having, err := parseHaving(it)
+				if err != nil {
+					return nil, err
+				}
+				gq.Having = having
 			case "ignorereflex":
 				gq.IgnoreReflex = true
+			case "include", "skip":
+				if err := parseCondition(it, gq, item.Val); err != nil {
+					return nil, err
+				}
 			case "recurse":
 				gq.Recurse = true
 				if err := parseRecurseArgs(it, gq); err != nil {
@@ -984,12 +1296,23 @@ func parseGqlVariables(it *lex.ItemIterator, vmap varMap) error {
 		// Get variable type.
 		it.Next()
 		item = it.Item()
+		isList := item.Typ == itemLeftSquare
+		if isList {
+			it.Next()
+			item = it.Item()
+		}
 		if item.Typ != itemName {
 			return x.Errorf("Expecting a variable type. Got: %v", item)
 		}
 
 		// Ensure that the type is not nil.
 		varType := item.Val
+		if isList {
+			if ok := trySkipItemTyp(it, itemRightSquare); !ok {
+				return x.Errorf("Expecting ] after the type of list variable %v", varName)
+			}
+			varType = "[" + varType + "]"
+		}
 		if varType == "" {
 			return x.Errorf("Type of a variable can't be empty")
 		}
@@ -1016,8 +1339,8 @@ func parseGqlVariables(it *lex.ItemIterator, vmap varMap) error {
 		// Check for '=' sign and optional default value.
 		if item.Typ == itemEqual {
 			it.Next()
-			it := it.Item()
-			if it.Typ != itemName {
+			def := it.Item()
+			if def.Typ != itemName && !(isList && def.Typ == itemLeftSquare) {
 				return x.Errorf("Expecting default value of a variable. Got: %v", item)
 			}
 
@@ -1025,13 +1348,18 @@ func parseGqlVariables(it *lex.ItemIterator, vmap varMap) error {
 				return x.Errorf("Type ending with ! can't have default value: Got: %v", varType)
 			}
 
+			uq, err := unquoteIfQuoted(def.Val)
+			if err != nil {
+				return err
+			}
+			if def.Typ == itemLeftSquare {
+				if uq, err = parseDefaultList(it); err != nil {
+					return err
+				}
+			}
 			// If value is empty replace, otherwise ignore the default value
 			// as the intialised value will override the default value.
 			if vmap[varName].Value == "" {
-				uq, err := unquoteIfQuoted(it.Val)
-				if err != nil {
-					return err
-				}
 				vmap[varName] = varInfo{
 					Value: uq,
 					Type:  varType,
@@ -1048,6 +1376,30 @@ func parseGqlVariables(it *lex.ItemIterator, vmap varMap) error {
 	return nil
 }
 
+// parseDefaultList parses the default value of a list variable, e.g. $ids: [uid] = [0x1, 0x2],
+// into a JSON array of strings.
+func parseDefaultList(it *lex.ItemIterator) (string, error) {
+	var list []string
+	for it.Next() {
+		item := it.Item()
+		switch item.Typ {
+		case itemRightSquare:
+			buf, err := json.Marshal(list)
+			return string(buf), err
+		case itemComma:
+		case itemName:
+			elem, err := unquoteIfQuoted(item.Val)
+			if err != nil {
+				return "", err
+			}
+			list = append(list, elem)
+		default:
+			return "", x.Errorf("Unexpected item in default value of a list. Got: %v", item)
+		}
+	}
+	return "", x.Errorf("Expecting ] after the default value of a list")
+}
+
 // unquoteIfQuoted checks if str is quoted (starts and ends with quotes). If
 // so, it tries to unquote str possibly returning an error. Otherwise, the
 // original value is returned.
@@ -1140,7 +1492,11 @@ func parseArguments(it *lex.ItemIterator, gq *GraphQuery) (result []pair, rerr e
 
 		// Get language list, if present
 		items, err := it.Peek(1)
-		if err == nil && items[0].Typ == itemAt {
+		if err == nil && items[0].Typ == itemLeftRound && isSortkey(p.Key) {
+			if p.Val, err = parseOrderAggregate(it, p.Val); err != nil {
+				return nil, err
+			}
+		} else if err == nil && items[0].Typ == itemAt {
 			it.Next() // consume '@'
 			it.Next() // move forward
 			langs, err := parseLanguageList(it)
@@ -1174,9 +1530,11 @@ func (t *FilterTree) stringHelper(buf *bytes.Buffer) {
 			buf.WriteRune(' ')
 			if t.Func.IsCount {
 				buf.WriteString("count(")
+			} else if t.Func.IsLenVar {
+				buf.WriteString("len(")
 			}
 			buf.WriteString(t.Func.Attr)
-			if t.Func.IsCount {
+			if t.Func.IsCount || t.Func.IsLenVar {
 				buf.WriteRune(')')
 			}
 			if len(t.Func.Lang) > 0 {
@@ -1197,6 +1555,10 @@ func (t *FilterTree) stringHelper(buf *bytes.Buffer) {
 					buf.WriteRune('"')
 				}
 			}
+			if t.Func.Filter != nil {
+				buf.WriteRune(' ')
+				t.Func.Filter.stringHelper(buf)
+			}
 		}
 		buf.WriteRune(')')
 		return
@@ -1319,6 +1681,81 @@ func parseGeoArgs(it *lex.ItemIterator, g *Function) error {
 	return nil
 }
 
+// parseTerms parses the arguments of terms(country, uid(people), first: 50, orderdesc: count),
+// after its opening bracket. They're kept in Args as the order, its direction, the first and the
+// offset, which are "count", "desc", "0" and "0" if not given.
+func parseTerms(it *lex.ItemIterator, f *Function) error {
+	item, ok := tryParseItemType(it, itemName)
+	if !ok {
+		return x.Errorf("Expected a predicate in terms")
+	}
+	f.Attr = collectName(it, item.Val)
+	order, desc, first, offset := "count", true, "0", "0"
+	seenOrder := false
+	for it.Next() {
+		item := it.Item()
+		if item.Typ == itemRightRound {
+			f.Args = []Arg{{Value: order}, {Value: "asc"}, {Value: first}, {Value: offset}}
+			if desc {
+				f.Args[1].Value = "desc"
+			}
+			return nil
+		}
+		if item.Typ != itemComma {
+			return x.Errorf("Expected comma or ) in terms, got: %s", item.Val)
+		}
+		if item, ok = tryParseItemType(it, itemName); !ok {
+			return x.Errorf("Expected an argument of terms after comma")
+		}
+		key := item.Val
+		if key == uid {
+			if _, ok := tryParseItemType(it, itemLeftRound); !ok {
+				return x.Errorf("Expected ( after uid in terms")
+			}
+			v, ok := tryParseItemType(it, itemName)
+			if !ok {
+				return x.Errorf("Expected a variable in uid() of terms")
+			}
+			f.NeedsVar = append(f.NeedsVar, VarContext{Name: v.Val, Typ: UID_VAR})
+			if _, ok := tryParseItemType(it, itemRightRound); !ok {
+				return x.Errorf("Expected ) after the variable in uid() of terms")
+			}
+			continue
+		}
+		if ok := trySkipItemTyp(it, itemColon); !ok {
+			return x.Errorf("Expected colon(:) after %s in terms", key)
+		}
+		val, ok := tryParseItemType(it, itemName)
+		if !ok {
+			return x.Errorf("Expected a value for %s in terms", key)
+		}
+		switch key {
+		case "first", "offset":
+			n, err := strconv.ParseUint(val.Val, 0, 32)
+			if err != nil {
+				return x.Errorf("Expected a number for %s in terms, got: %s", key, val.Val)
+			}
+			if key == "first" {
+				first = strconv.FormatUint(n, 10)
+			} else {
+				offset = strconv.FormatUint(n, 10)
+			}
+		case "orderasc", "orderdesc":
+			if seenOrder {
+				return x.Errorf("Only one order allowed in terms")
+			}
+			if val.Val != "count" && val.Val != "term" {
+				return x.Errorf("terms can only be ordered by count or term, got: %s", val.Val)
+			}
+			seenOrder = true
+			order, desc = val.Val, key == "orderdesc"
+		default:
+			return x.Errorf("Unknown argument %s in terms", key)
+		}
+	}
+	return x.Errorf("Expected ) after the arguments of terms")
+}
+
 func validFuncName(name string) bool {
 	if isGeoFunc(name) || isInequalityFn(name) {
 		return true
@@ -1326,7 +1763,8 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext",
-		"has", "uid", "uid_in", "anyof", "allof":
+		"has", "uid", "uid_in", "anyof", "allof", "match", "similar_to", "phrase",
+		"near_words", "prefix", "terms":
 		return true
 	}
 	return false
@@ -1369,6 +1807,15 @@ L:
 		if _, ok := tryParseItemType(it, itemLeftRound); !ok {
 			return nil, x.Errorf("Expected ( after func name [%s]", function.Name)
 		}
+		if function.Name == "terms" {
+			if gq == nil {
+				return nil, x.Errorf("terms() can only be used at root")
+			}
+			if err := parseTerms(it, function); err != nil {
+				return nil, err
+			}
+			break L
+		}
 
 		attrItemsAgo := -1
 		expectArg = true
@@ -1415,6 +1862,14 @@ L:
 					}
 					function.NeedsVar = append(function.NeedsVar, nestedFunc.NeedsVar...)
 					function.NeedsVar[0].Typ = VALUE_VAR
+				} else if nestedFunc.Name == "len" {
+					// Number of uids or values in a variable, eq(len(a), 0)
+					function.Attr = nestedFunc.Attr
+					function.IsLenVar = true
+					function.NeedsVar = append(function.NeedsVar, VarContext{
+						Name: nestedFunc.Attr,
+						Typ:  ANY_VAR,
+					})
 				} else {
 					if nestedFunc.Name != "count" {
 						return nil,
@@ -1578,6 +2033,16 @@ L:
 		return nil, x.Errorf("Got empty attr for function: [%s]", function.Name)
 	}
 
+	if function.Name == "type" {
+		// type(Person) is the same as eq(_type_, "Person").
+		if len(function.Args) > 0 || function.IsValueVar || function.IsCount {
+			return nil, x.Errorf("type() expects exactly one type name")
+		}
+		function.Name = "eq"
+		function.Args = []Arg{{Value: function.Attr}}
+		function.Attr = x.TypeAttr
+	}
+
 	return function, nil
 }
 
@@ -1789,6 +2254,16 @@ func parseGroupby(it *lex.ItemIterator, gq *GraphQuery) error {
 			if err != nil {
 				return err
 			}
+			if peekIt[0].Typ == itemColon && alias == "" {
+				isArg, err := parseGroupbyArg(it, gq, val)
+				if err != nil {
+					return err
+				}
+				if isArg {
+					expectArg = false
+					continue
+				}
+			}
 			if peekIt[0].Typ == itemColon {
 				if alias != "" {
 					return x.Errorf("Expected predicate after %s:", alias)
@@ -1828,12 +2303,184 @@ func parseGroupby(it *lex.ItemIterator, gq *GraphQuery) error {
 	return nil
 }
 
+// parseGroupbyArg parses the ordering or pagination of the groups given as key: value in
+// @groupby, like orderdesc: count(uid) or first: 10. It returns false if key is the alias of
+// a predicate instead.
+func parseGroupbyArg(it *lex.ItemIterator, gq *GraphQuery, key string) (bool, error) {
+	switch key {
+	case "orderasc", "orderdesc":
+		items, err := it.Peek(3)
+		if err != nil || items[2].Typ != itemLeftRound {
+			return false, nil
+		}
+		it.Next() // Consume the itemColon
+		it.Next()
+		agg, err := parseGroupAggregate(it)
+		if err != nil {
+			return true, err
+		}
+		gq.GroupbyOrder = append(gq.GroupbyOrder, GroupByOrder{
+			Aggregate: agg,
+			Desc:      key == "orderdesc",
+		})
+		return true, nil
+	case "first", "offset":
+		items, err := it.Peek(2)
+		if err != nil {
+			return false, nil
+		}
+		n, err := strconv.Atoi(items[1].Val)
+		if err != nil {
+			return false, nil
+		}
+		it.Next() // Consume the itemColon
+		it.Next()
+		if key == "first" {
+			gq.GroupbyFirst = n
+		} else {
+			gq.GroupbyOffset = n
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// parseGroupAggregate parses an aggregate of the members of a group, starting at its name.
+func parseGroupAggregate(it *lex.ItemIterator) (*GroupAggregate, error) {
+	name := strings.ToLower(it.Item().Val)
+	if _, ok := tryParseItemType(it, itemLeftRound); !ok {
+		return nil, x.Errorf("Expected ( after %s", name)
+	}
+	if !it.Next() {
+		return nil, x.Errorf("Unexpected end of %s()", name)
+	}
+	if name == "count" {
+		item := it.Item()
+		if item.Val == uid {
+			if _, ok := tryParseItemType(it, itemRightRound); !ok {
+				return nil, x.Errorf("Expected ) after count(uid")
+			}
+			return &GroupAggregate{Name: name, Attr: uid}, nil
+		}
+		if strings.ToLower(item.Val) != "distinct" || !it.Next() {
+			return nil, x.Errorf("Expected count(uid) or count(distinct predicate). Got: count(%s",
+				item.Val)
+		}
+		name = "count_distinct"
+	} else if !isAggregator(name) {
+		return nil, x.Errorf("Expected count or an aggregator function. Got: %s", name)
+	}
+	child := &GraphQuery{}
+	if err := parseAggregator(it, &GraphQuery{IsGroupby: true}, child, name); err != nil {
+		return nil, err
+	}
+	return &GroupAggregate{
+		Name:  name,
+		Attr:  child.Attr,
+		Langs: child.Langs,
+		Args:  child.Func.Args,
+	}, nil
+}
+
+// parseHavingFunction parses a comparison of an aggregate of the groups with a value, like
+// gt(count(uid), 10), inside @having.
+func parseHavingFunction(it *lex.ItemIterator) (*Function, error) {
+	it.Next()
+	name := strings.ToLower(it.Item().Val)
+	if !isInequalityFn(name) {
+		return nil, x.Errorf("Only eq, le, lt, ge and gt are allowed in @having. Got: %s", name)
+	}
+	if _, ok := tryParseItemType(it, itemLeftRound); !ok {
+		return nil, x.Errorf("Expected ( after func name [%s]", name)
+	}
+	it.Next()
+	agg, err := parseGroupAggregate(it)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := tryParseItemType(it, itemComma); !ok {
+		return nil, x.Errorf("Expected a value to compare %s(%s) with", agg.Name, agg.Attr)
+	}
+	it.Next()
+	item := it.Item()
+	var val string
+	if item.Typ == itemMathOp {
+		val = item.Val
+		it.Next()
+		item = it.Item()
+	}
+	if item.Typ != itemName {
+		return nil, x.Errorf("Expected a value to compare %s(%s) with. Got: %v", agg.Name,
+			agg.Attr, item.Val)
+	}
+	v, err := unquoteIfQuoted(item.Val)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := tryParseItemType(it, itemRightRound); !ok {
+		return nil, x.Errorf("Expected ) at the end of %s()", name)
+	}
+	return &Function{
+		Name:      name,
+		Attr:      agg.Attr,
+		Args:      []Arg{{Value: val + v}},
+		Aggregate: agg,
+	}, nil
+}
+
 // parseFilter parses the filter directive to produce a QueryFilter / parse tree.
 func parseFilter(it *lex.ItemIterator) (*FilterTree, error) {
+	return parseFilterTree(it, "filter", func(it *lex.ItemIterator) (*Function, error) {
+		if item, ok := it.PeekOne(); ok && strings.ToLower(item.Val) == "exists" {
+			return parseExists(it)
+		}
+		return parseFunction(it, nil)
+	})
+}
+
+// parseExists parses exists(orders) and exists(orders @filter(gt(amount, 100))), which match
+// the nodes with an edge of the predicate, to a node matching the filter if there's one.
+func parseExists(it *lex.ItemIterator) (*Function, error) {
+	it.Next()
+	function := &Function{Name: "exists"}
+	if _, ok := tryParseItemType(it, itemLeftRound); !ok {
+		return nil, x.Errorf("Expected ( after func name [exists]")
+	}
+	item, ok := tryParseItemType(it, itemName)
+	if !ok {
+		return nil, x.Errorf("Expected a predicate in exists")
+	}
+	function.Attr = collectName(it, item.Val)
+	if _, ok := tryParseItemType(it, itemAt); ok {
+		item, ok := tryParseItemType(it, itemName)
+		if !ok || strings.ToLower(item.Val) != "filter" {
+			return nil, x.Errorf("Only @filter is allowed inside exists")
+		}
+		filter, err := parseFilter(it)
+		if err != nil {
+			return nil, err
+		}
+		function.Filter = filter
+	}
+	if _, ok := tryParseItemType(it, itemRightRound); !ok {
+		return nil, x.Errorf("Expected ) after the predicate of exists")
+	}
+	return function, nil
+}
+
+// parseHaving parses the having directive, which filters the groups formed by @groupby.
+func parseHaving(it *lex.ItemIterator) (*FilterTree, error) {
+	return parseFilterTree(it, "having", parseHavingFunction)
+}
+
+// parseFilterTree parses the functions of a filter-like directive combined with and, or and
+// not, using parseLeaf to parse each function.
+func parseFilterTree(it *lex.ItemIterator, directive string,
+	parseLeaf func(*lex.ItemIterator) (*Function, error)) (*FilterTree, error) {
 	it.Next()
 	item := it.Item()
 	if item.Typ != itemLeftRound {
-		return nil, x.Errorf("Expected ( after filter directive")
+		return nil, x.Errorf("Expected ( after %s directive", directive)
 	}
 
 	// opStack is used to collect the operators in right order.
@@ -1863,7 +2510,7 @@ func parseFilter(it *lex.ItemIterator) (*FilterTree, error) {
 			opStack.push(&FilterTree{Op: op}) // Push current operator.
 		} else if item.Typ == itemName { // Value.
 			it.Prev()
-			f, err := parseFunction(it, nil)
+			f, err := parseLeaf(it)
 			if err != nil {
 				return nil, err
 			}
@@ -1892,7 +2539,7 @@ func parseFilter(it *lex.ItemIterator) (*FilterTree, error) {
 				break
 			}
 		} else {
-			return nil, x.Errorf("Unexpected item while parsing @filter: %v", item)
+			return nil, x.Errorf("Unexpected item while parsing @%s: %v", directive, item)
 		}
 	}
 
@@ -1902,7 +2549,7 @@ func parseFilter(it *lex.ItemIterator) (*FilterTree, error) {
 	// consumed, we will run a loop like "while opStack is nonempty, evalStack".
 	// This is not needed here.
 	if !opStack.empty() {
-		return nil, x.Errorf("Unbalanced parentheses in @filter statement")
+		return nil, x.Errorf("Unbalanced parentheses in @%s statement", directive)
 	}
 
 	if valueStack.empty() {
@@ -2014,7 +2661,16 @@ func parseDirective(it *lex.ItemIterator, curp *GraphQuery) error {
 		return x.Errorf("Expected directive or language list")
 	}
 
-	if item.Val == "facets" { // because @facets can come w/t '()'
+	if item.Val == "cascade" {
+		if err := parseCascade(it, curp); err != nil {
+			return err
+		}
+	} else if item.Val == "highlight" && peek[0].Typ != itemLeftRound {
+		if curp.Highlight {
+			return x.Errorf("Only one highlight directive allowed.")
+		}
+		curp.Highlight = true
+	} else if item.Val == "facets" { // because @facets can come w/t '()'
 		res, err := parseFacets(it)
 		if err != nil {
 			return err
@@ -2056,7 +2712,22 @@ func parseDirective(it *lex.ItemIterator, curp *GraphQuery) error {
 				return x.Errorf("Only one group by directive allowed.")
 			}
 			curp.IsGroupby = true
-			parseGroupby(it, curp)
+			if err := parseGroupby(it, curp); err != nil {
+				return err
+			}
+		case "having":
+			if curp.Having != nil {
+				return x.Errorf("Only one having directive allowed.")
+			}
+			having, err := parseHaving(it)
+			if err != nil {
+				return err
+			}
+			curp.Having = having
+		case "include", "skip":
+			if err := parseCondition(it, curp, item.Val); err != nil {
+				return err
+			}
 		default:
 			return x.Errorf("Unknown directive [%s]", item.Val)
 		}
@@ -2102,7 +2773,7 @@ func parseLanguageList(it *lex.ItemIterator) ([]string, error) {
 
 func validKeyAtRoot(k string) bool {
 	switch k {
-	case "func", "orderasc", "orderdesc", "first", "offset", "after":
+	case "func", "orderasc", "orderdesc", "nulls", "first", "offset", "after", "random", "seed":
 		return true
 	case "from", "to", "numpaths":
 		// Specific to shortest path
@@ -2116,7 +2787,7 @@ func validKeyAtRoot(k string) bool {
 // Check for validity of key at non-root nodes.
 func validKey(k string) bool {
 	switch k {
-	case "orderasc", "orderdesc", "first", "offset", "after":
+	case "orderasc", "orderdesc", "nulls", "first", "offset", "after", "random", "seed":
 		return true
 	}
 	return false
@@ -2261,9 +2932,9 @@ func getRoot(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
 				val = collectName(it, val+item.Val)
 				// Get language list, if present
 				items, err := it.Peek(1)
-				if err == nil && items[0].Typ == itemLeftRound {
-					if (key == "orderasc" || key == "orderdesc") && val != value {
-						return nil, x.Errorf("Expected val(). Got %s() with order.", val)
+				if err == nil && items[0].Typ == itemLeftRound && isSortkey(key) {
+					if val, err = parseOrderAggregate(it, val); err != nil {
+						return nil, err
 					}
 				}
 				if err == nil && items[0].Typ == itemAt {
@@ -2291,11 +2962,16 @@ func getRoot(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
 				if order[val] {
 					return nil, x.Errorf("Sorting by an attribute: [%s] can only be done once", val)
 				}
-				attr, langs := attrAndLang(val)
-				gq.Order = append(gq.Order, &intern.Order{attr, key == "orderdesc", langs})
+				gq.Order = append(gq.Order, newOrder(key, val))
 				order[val] = true
 				continue
 			}
+			if key == "nulls" {
+				if err := setNulls(gq.Order, val); err != nil {
+					return nil, err
+				}
+				continue
+			}
 
 		ASSIGN:
 			if _, ok := gq.Args[key]; ok {
@@ -2312,6 +2988,61 @@ func isSortkey(k string) bool {
 	return k == "orderasc" || k == "orderdesc"
 }
 
+// parseOrderAggregate parses the rest of an order by first, min or max of the values of a
+// predicate, starting at its name, and returns it as name(predicate@langs).
+func parseOrderAggregate(it *lex.ItemIterator, name string) (string, error) {
+	if name != "first" && name != "min" && name != "max" {
+		return "", x.Errorf("Expected val(). Got %s() with order.", name)
+	}
+	it.Next() // Consume the '('
+	if !it.Next() || it.Item().Typ != itemName {
+		return "", x.Errorf("Expected a predicate inside %s() with order.", name)
+	}
+	pred := collectName(it, it.Item().Val)
+	items, err := it.Peek(1)
+	if err == nil && items[0].Typ == itemAt {
+		it.Next() // consume '@'
+		it.Next() // move forward
+		langs, err := parseLanguageList(it)
+		if err != nil {
+			return "", err
+		}
+		pred += "@" + strings.Join(langs, ":")
+	}
+	if _, ok := tryParseItemType(it, itemRightRound); !ok {
+		return "", x.Errorf("Expected ) after %s(%s", name, pred)
+	}
+	return name + "(" + pred + ")", nil
+}
+
+// newOrder returns the order given by the sort key, by a predicate, a path of them like
+// works_at.name, or first, min or max of the values of either like min(works_at.founded).
+func newOrder(key, val string) *intern.Order {
+	order := &intern.Order{Desc: key == "orderdesc"}
+	if i := strings.Index(val, "("); i > 0 && strings.HasSuffix(val, ")") {
+		order.Aggregate = val[:i]
+		val = val[i+1 : len(val)-1]
+	}
+	order.Attr, order.Langs = attrAndLang(val)
+	return order
+}
+
+// setNulls sets where the nodes without a value go in the last order given.
+func setNulls(order []*intern.Order, val string) error {
+	if len(order) == 0 {
+		return x.Errorf("nulls should follow orderasc or orderdesc")
+	}
+	if val != "first" && val != "last" {
+		return x.Errorf("Expected nulls to be first or last. Got: %s", val)
+	}
+	last := order[len(order)-1]
+	if last.Nulls != "" {
+		return x.Errorf("Repeated nulls for the order by %s", last.Attr)
+	}
+	last.Nulls = val
+	return nil
+}
+
 type Count int
 
 const (
@@ -2337,6 +3068,13 @@ func validateEmptyBlockItem(it *lex.ItemIterator, val string) error {
 		fname = item.Val
 	}
 	ok := trySkipItemTyp(it, itemLeftRound)
+	if ok && strings.ToLower(fname) == "count" {
+		// count(distinct val(x)) is an aggregator.
+		item, _ := tryParseItemType(it, itemName)
+		if strings.ToLower(item.Val) == "distinct" {
+			return nil
+		}
+	}
 	if !ok || (!isMathBlock(fname) && !isAggregator(fname)) {
 		return x.Errorf("Only aggregation/math functions allowed inside empty blocks."+
 			" Got: %v", fname)
@@ -2446,39 +3184,9 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					goto Fall
 				}
 				it.Next()
-				if gq.IsGroupby {
-					item = it.Item()
-					attr := collectName(it, item.Val)
-					// Get language list, if present
-					items, err := it.Peek(1)
-					if err == nil && items[0].Typ == itemAt {
-						it.Next() // consume '@'
-						it.Next() // move forward
-						if child.Langs, err = parseLanguageList(it); err != nil {
-							return err
-						}
-					}
-					child.Attr = attr
-					child.IsInternal = false
-				} else {
-					if it.Item().Val != value {
-						return x.Errorf("Only variables allowed in aggregate functions. Got: %v",
-							it.Item().Val)
-					}
-					count, err := parseVarList(it, child)
-					if err != nil {
-						return err
-					}
-					if count != 1 {
-						x.Errorf("Expected one variable inside val() of aggregator but got %v", count)
-					}
-					child.NeedsVar[len(child.NeedsVar)-1].Typ = VALUE_VAR
-				}
-				child.Func = &Function{
-					Name:     valLower,
-					NeedsVar: child.NeedsVar,
+				if err := parseAggregator(it, gq, child, valLower); err != nil {
+					return err
 				}
-				it.Next() // Skip the closing ')'
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
@@ -2536,6 +3244,9 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					child.Expand = child.NeedsVar[len(child.NeedsVar)-1].Name
 				} else if item.Val == "_all_" {
 					child.Expand = "_all_"
+				} else if item.Typ == itemName {
+					// expand(Person) expands the fields of the type.
+					child.Expand = item.Val
 				} else {
 					return x.Errorf("Invalid argument %v in expand()", item.Val)
 				}
@@ -2563,6 +3274,26 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				}
 				if peekIt[0].Typ == itemRightRound {
 					return x.Errorf("Cannot use count(), please use count(uid)")
+				} else if strings.ToLower(peekIt[0].Val) == "distinct" &&
+					peekIt[1].Typ != itemRightRound {
+					// count(distinct val(x)) is an aggregator.
+					count = notSeen
+					child := &GraphQuery{
+						Attr:       value,
+						Args:       make(map[string]string),
+						Var:        varName,
+						IsInternal: true,
+						Alias:      alias,
+					}
+					varName, alias = "", ""
+					it.Next() // Consume "distinct"
+					it.Next()
+					if err := parseAggregator(it, gq, child, "count_distinct"); err != nil {
+						return err
+					}
+					gq.Children = append(gq.Children, child)
+					curp = nil
+					continue
 				} else if peekIt[0].Val == uid && peekIt[1].Typ == itemRightRound {
 					if gq.IsGroupby {
 						// count(uid) case which occurs inside @groupby
@@ -2691,11 +3422,16 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					if order[p.Val] {
 						return x.Errorf("Sorting by an attribute: [%s] can only be done once", p.Val)
 					}
-					attr, langs := attrAndLang(p.Val)
-					curp.Order = append(curp.Order, &intern.Order{attr, p.Key == "orderdesc", langs})
+					curp.Order = append(curp.Order, newOrder(p.Key, p.Val))
 					order[p.Val] = true
 					continue
 				}
+				if p.Key == "nulls" {
+					if err := setNulls(curp.Order, p.Val); err != nil {
+						return err
+					}
+					continue
+				}
 
 				curp.Args[p.Key] = p.Val
 			}
@@ -2716,7 +3452,69 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 }
 
 func isAggregator(fname string) bool {
-	return fname == "min" || fname == "max" || fname == "sum" || fname == "avg"
+	switch fname {
+	case "min", "max", "sum", "avg", "count_distinct", "median", "percentile", "stddev",
+		"variance":
+		return true
+	}
+	return false
+}
+
+// parseAggregator parses the arguments of the aggregator fname, starting with the item after
+// the '('. It's a predicate inside @groupby and a value variable elsewhere, followed by the
+// percentile for percentile().
+func parseAggregator(it *lex.ItemIterator, gq *GraphQuery, child *GraphQuery,
+	fname string) error {
+	if gq.IsGroupby {
+		item := it.Item()
+		attr := collectName(it, item.Val)
+		// Get language list, if present
+		items, err := it.Peek(1)
+		if err == nil && items[0].Typ == itemAt {
+			it.Next() // consume '@'
+			it.Next() // move forward
+			if child.Langs, err = parseLanguageList(it); err != nil {
+				return err
+			}
+		}
+		child.Attr = attr
+		child.IsInternal = false
+	} else {
+		if it.Item().Val != value {
+			return x.Errorf("Only variables allowed in aggregate functions. Got: %v",
+				it.Item().Val)
+		}
+		count, err := parseVarList(it, child)
+		if err != nil {
+			return err
+		}
+		if count != 1 {
+			x.Errorf("Expected one variable inside val() of aggregator but got %v", count)
+		}
+		child.NeedsVar[len(child.NeedsVar)-1].Typ = VALUE_VAR
+	}
+	child.Func = &Function{
+		Name:     fname,
+		NeedsVar: child.NeedsVar,
+	}
+	if fname == "percentile" {
+		it.Next()
+		if it.Item().Typ != itemComma {
+			return x.Errorf("Expected the percentile after the argument of percentile()")
+		}
+		it.Next()
+		p, err := strconv.ParseFloat(it.Item().Val, 64)
+		if err != nil || p < 0 || p > 1 {
+			return x.Errorf("Percentile should be a number between 0 and 1. Got: %v",
+				it.Item().Val)
+		}
+		child.Func.Args = append(child.Func.Args, Arg{Value: it.Item().Val})
+	}
+	it.Next() // Skip the closing ')'
+	if it.Item().Typ != itemRightRound {
+		return x.Errorf("Expected ) at the end of %v(). Got: %v", fname, it.Item().Val)
+	}
+	return nil
 }
 
 func isExpandFunc(name string) bool {
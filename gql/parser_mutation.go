@@ -10,6 +10,8 @@ package gql
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/lex"
@@ -88,3 +90,171 @@ func parseMutationOp(it *lex.ItemIterator, op string, mu *api.Mutation) error {
 	}
 	return x.Errorf("Invalid mutation formatting.")
 }
+
+// Upsert stores the query and the mutation of an upsert block. The uid and value variables
+// defined in the query can be referenced in the mutation through uid(v) and val(v). If Cond is
+// set, the mutation is only applied when the condition holds for the variables of the query.
+type Upsert struct {
+	Query    string
+	Mutation *api.Mutation
+	Cond     string
+}
+
+// IsUpsert returns true if the mutation string is an upsert block, i.e. starts with the upsert
+// keyword followed by a left curly bracket.
+func IsUpsert(mutation string) bool {
+	m := strings.TrimSpace(mutation)
+	if !strings.HasPrefix(m, "upsert") {
+		return false
+	}
+	return strings.HasPrefix(strings.TrimSpace(m[len("upsert"):]), string(leftCurl))
+}
+
+// ParseUpsert parses an upsert block of the form
+//
+//	upsert {
+//	  query {
+//	    ...
+//	  }
+//	  mutation @if(eq(len(v), 0)) {
+//	    set { ... }
+//	    delete { ... }
+//	  }
+//	}
+//
+// The @if directive of the mutation block is optional.
+func ParseUpsert(upsert string) (*Upsert, error) {
+	lexer := lex.Lexer{Input: upsert}
+	lexer.Run(lexUpsertBlock)
+	it := lexer.NewIterator()
+
+	if !it.Next() || it.Item().Typ != itemName || it.Item().Val != "upsert" {
+		return nil, x.Errorf("Expected upsert keyword at the start of block.")
+	}
+	if !it.Next() || it.Item().Typ != itemLeftCurl {
+		return nil, x.Errorf("Expected { after upsert keyword. Got: [%s]", it.Item().Val)
+	}
+
+	up := &Upsert{}
+	for it.Next() {
+		item := it.Item()
+		switch {
+		case item.Typ == lex.ItemError:
+			return nil, x.Errorf(item.Val)
+		case item.Typ == itemRightCurl:
+			if it.Next(); it.Item().Typ != lex.ItemEOF {
+				return nil, x.Errorf("Unexpected content after upsert block: [%s]",
+					it.Item().Val)
+			}
+			if len(up.Query) == 0 {
+				return nil, x.Errorf("Upsert block must have a query block.")
+			}
+			if up.Mutation == nil {
+				return nil, x.Errorf("Upsert block must have a mutation block.")
+			}
+			return up, nil
+		case item.Typ == itemName && item.Val == "query":
+			if len(up.Query) > 0 {
+				return nil, x.Errorf("Only one query block allowed inside upsert block.")
+			}
+			if !it.Next() || it.Item().Typ != itemUpsertBlockContent {
+				return nil, x.Errorf("Expected { after query inside upsert block.")
+			}
+			up.Query = it.Item().Val
+		case item.Typ == itemName && item.Val == "mutation":
+			if up.Mutation != nil {
+				return nil, x.Errorf("Only one mutation block allowed inside upsert block.")
+			}
+			if err := parseUpsertMutation(it, up); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, x.Errorf("Invalid block [%s] inside upsert block.", item.Val)
+		}
+	}
+	return nil, x.Errorf("Unclosed upsert block.")
+}
+
+// parseUpsertMutation parses the mutation block of an upsert block, along with the condition of
+// its @if directive if there's one.
+func parseUpsertMutation(it *lex.ItemIterator, up *Upsert) error {
+	if !it.Next() {
+		return x.Errorf("Expected { after mutation inside upsert block.")
+	}
+	if it.Item().Typ == itemAt {
+		if !it.Next() || it.Item().Typ != itemName || it.Item().Val != "if" {
+			return x.Errorf("Invalid directive [%s] for mutation inside upsert block.",
+				it.Item().Val)
+		}
+		if !it.Next() || it.Item().Typ != itemUpsertBlockContent ||
+			it.Item().Val[0] != leftRound {
+			return x.Errorf("Expected condition inside round brackets after @if.")
+		}
+		cond := it.Item().Val
+		up.Cond = cond[1 : len(cond)-1]
+		// Report invalid conditions while parsing the upsert block.
+		if _, err := ParseCond(up.Cond); err != nil {
+			return err
+		}
+		it.Next()
+	}
+	item := it.Item()
+	if item.Typ != itemUpsertBlockContent || item.Val[0] != leftCurl {
+		return x.Errorf("Expected { after mutation inside upsert block. Got: [%s]", item.Val)
+	}
+	var err error
+	up.Mutation, err = ParseMutation(item.Val)
+	return err
+}
+
+// ParseCond parses the condition of a conditional mutation, e.g. eq(len(u), 0). A condition is
+// written like a filter whose functions compare the number of uids or values in a variable, given
+// by len(v), with an integer. Functions can be combined with and, or and not.
+func ParseCond(cond string) (*FilterTree, error) {
+	// The condition is lexed like the arguments of a filter inside a query block. The block is
+	// then closed, so that the lexer reaches the end of input without an error.
+	lexer := lex.Lexer{Input: "(" + cond + ")}", Depth: 1}
+	lexer.Run(lexQuery)
+	it := lexer.NewIterator()
+
+	ft, err := parseFilter(it)
+	if err != nil {
+		return nil, err
+	}
+	if ft == nil {
+		return nil, x.Errorf("Empty condition in @if directive.")
+	}
+	if it.Next(); it.Item().Typ != itemRightCurl {
+		if it.Item().Typ == lex.ItemError {
+			return nil, x.Errorf(it.Item().Val)
+		}
+		return nil, x.Errorf("Unexpected item after condition: %v", it.Item())
+	}
+	if err := validateCond(ft); err != nil {
+		return nil, err
+	}
+	return ft, nil
+}
+
+func validateCond(ft *FilterTree) error {
+	if f := ft.Func; f != nil {
+		if !f.IsLenVar || !isInequalityFn(f.Name) {
+			return x.Errorf("Only eq, le, lt, ge and gt on len() of a variable are allowed in "+
+				"condition. Got: %s", f.Name)
+		}
+		if len(f.Args) != 1 {
+			return x.Errorf("Expected one argument to compare with len(%s). Got: %d",
+				f.Attr, len(f.Args))
+		}
+		if _, err := strconv.ParseInt(f.Args[0].Value, 0, 64); err != nil {
+			return x.Errorf("Expected an integer to compare with len(%s). Got: %s",
+				f.Attr, f.Args[0].Value)
+		}
+	}
+	for _, ch := range ft.Child {
+		if err := validateCond(ch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
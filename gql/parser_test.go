@@ -14,6 +14,7 @@ import (
 	"testing"
 
 	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/rdf"
 	"github.com/stretchr/testify/require"
 )
@@ -73,6 +74,54 @@ func TestParseQueryListPred1(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseExpandType(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x0a)) {
+			expand(Person) {
+				name
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	child := res.Query[0].Children[0]
+	require.Equal(t, "Person", child.Expand)
+	require.Empty(t, child.NeedsVar)
+	require.Equal(t, "name", child.Children[0].Attr)
+}
+
+func TestParseTypeFunction(t *testing.T) {
+	query := `
+	{
+		me(func: type(Person)) @filter(type(Student) or has(name)) {
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "eq", res.Query[0].Func.Name)
+	require.Equal(t, "_type_", res.Query[0].Func.Attr)
+	require.Equal(t, []Arg{{Value: "Person"}}, res.Query[0].Func.Args)
+	require.Equal(t, `(OR (eq _type_ "Student") (has name))`,
+		res.Query[0].Filter.debugString())
+}
+
+func TestParseTypeFunctionError(t *testing.T) {
+	query := `
+	{
+		me(func: type(Person, Student)) {
+			name
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type() expects exactly one type name")
+}
+
 func TestParseQueryAliasListPred(t *testing.T) {
 	query := `
 	{
@@ -1219,6 +1268,22 @@ func TestParseOffset(t *testing.T) {
 	require.Equal(t, res.Query[0].Children[1].Args["offset"], "3")
 }
 
+func TestParseRandom(t *testing.T) {
+	query := `
+	query {
+		user(func: has(name), random: 5, seed: 42) {
+			name
+			friends (random: 2) {
+			}
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "5", res.Query[0].Args["random"])
+	require.Equal(t, "42", res.Query[0].Args["seed"])
+	require.Equal(t, "2", res.Query[0].Children[1].Args["random"])
+}
+
 func TestParseOffset_error(t *testing.T) {
 	query := `
 	query {
@@ -1755,6 +1820,101 @@ func TestParseStringVarInFilter(t *testing.T) {
 	require.Equal(t, "v0.7.3/beta", res.Query[0].Children[0].Filter.Func.Args[0].Value)
 }
 
+func TestParseListVars(t *testing.T) {
+	query := `
+		query test($ids: [uid], $names: [string] = ["Alice", "Bob"], $since: datetime,
+			$loc: geo) {
+			me(func: uid($ids)) @filter(eq(name, $names) and ge(joined, $since)) {
+				friend @filter(uid($ids)) {
+					name
+				}
+			}
+			near(func: near(loc, $loc, 1000)) {
+				name
+			}
+		}
+	`
+	res, err := Parse(Request{Str: query, Variables: map[string]string{
+		"$ids":   `["0x1", 2]`,
+		"$since": "2018-01-02",
+		"$loc":   "[-122.4, 37.7]",
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, res.Query[0].UID)
+	require.Equal(t, `(AND (eq name "Alice" "Bob") (ge joined "2018-01-02"))`,
+		res.Query[0].Filter.debugString())
+	require.Equal(t, []uint64{1, 2}, res.Query[0].Children[0].Filter.Func.UID)
+	require.Equal(t, "[-122.4, 37.7]", res.Query[1].Func.Args[0].Value)
+}
+
+func TestParseListVarsError(t *testing.T) {
+	query := `
+		query test($ids: [uid]) {
+			me(func: uid($ids)) {
+				name
+			}
+		}
+	`
+	_, err := Parse(Request{Str: query, Variables: map[string]string{"$ids": `["0x1", "b"]`}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid element in list variable $ids")
+
+	_, err = Parse(Request{Str: query, Variables: map[string]string{"$ids": "0x1"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected a list of uid for variable $ids")
+
+	query = `
+		query test($since: datetime, $loc: geo) {
+			me(func: near(loc, $loc, 10)) @filter(ge(joined, $since)) {
+				name
+			}
+		}
+	`
+	_, err = Parse(Request{Str: query, Variables: map[string]string{"$since": "yesterday"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected a datetime but got yesterday")
+
+	_, err = Parse(Request{Str: query, Variables: map[string]string{"$loc": "[1, 2, 3]"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected the coordinates of a point or polygon")
+}
+
+func TestParseCascadeFields(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) @cascade(name, friend) {
+				name
+				friend @cascade {
+					name
+				}
+				follower @cascade(first-name) {
+					first-name
+				}
+			}
+		}
+	`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Query[0].Cascade)
+	require.Equal(t, []string{"name", "friend"}, res.Query[0].CascadeFields)
+	require.True(t, res.Query[0].Children[1].Cascade)
+	require.Empty(t, res.Query[0].Children[1].CascadeFields)
+	require.Equal(t, []string{"first-name"}, res.Query[0].Children[2].CascadeFields)
+}
+
+func TestParseCascadeFieldsError(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) @cascade(name,) {
+				name
+			}
+		}
+	`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected a list of fields in @cascade")
+}
+
 func TestParseVariablesError1(t *testing.T) {
 	query := `
 	query testQuery($a: string, $b: int!){
@@ -2057,6 +2217,100 @@ func TestParseFilter_brac(t *testing.T) {
 		res.Query[0].Children[0].Filter.debugString())
 }
 
+func TestParseFilterExists(t *testing.T) {
+	query := `
+	{
+		x as var(func: has(price))
+
+		me(func: has(name)) @filter(not exists(~owner @filter(gt(amount, 100) and exists(items @filter(uid(x))))) or eq(age, 10)) {
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t,
+		`(OR (NOT (exists ~owner (AND (gt amount "100") (exists items (uid))))) (eq age "10"))`,
+		res.Query[1].Filter.debugString())
+	require.Equal(t, []string{"x"}, res.Query[1].Filter.NeedVars())
+}
+
+func TestParseFilterExistsGraphQLVar(t *testing.T) {
+	query := `
+	query test($amount: int) {
+		me(func: has(name)) @filter(exists(owner @filter(gt(amount, $amount)))) {
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Variables: map[string]string{"$amount": "100"}})
+	require.NoError(t, err)
+	require.Equal(t, `(exists owner (gt amount "100"))`, res.Query[0].Filter.debugString())
+}
+
+func TestParseFilterExistsError(t *testing.T) {
+	query := `
+	{
+		me(func: has(name)) @filter(exists(owner @facets(weight))) {
+			name
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Only @filter is allowed inside exists")
+}
+
+func TestParseIncludeSkip(t *testing.T) {
+	query := `
+	query test($withFriends: bool, $noAge: bool = true) {
+		me(func: uid(1)) @include(if: true) {
+			name
+			age @skip(if: $noAge)
+			friend @include(if: $withFriends) {
+				name
+			}
+		}
+		other(func: uid(2)) @skip(if: $noAge) {
+			name
+		}
+	}
+`
+	res, err := Parse(Request{Str: query, Variables: map[string]string{"$withFriends": "false"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Query))
+	require.False(t, res.Query[0].Skipped())
+	require.Equal(t, "true", res.Query[0].Children[1].SkipIf)
+	require.True(t, res.Query[0].Children[1].Skipped())
+	require.Equal(t, "false", res.Query[0].Children[2].IncludeIf)
+	require.True(t, res.Query[0].Children[2].Skipped())
+	require.True(t, res.Query[1].Skipped())
+}
+
+func TestParseIncludeSkipError(t *testing.T) {
+	query := `
+	query test($flag: string) {
+		me(func: uid(1)) {
+			name @include(if: $flag)
+		}
+	}
+`
+	_, err := Parse(Request{Str: query, Variables: map[string]string{"$flag": "yes"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected a bool in the condition of @include or @skip")
+
+	query = `
+	{
+		me(func: uid(1)) @skip(if: maybe) {
+			name
+		}
+	}
+`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected a bool inside @skip(), got: maybe")
+}
+
 // Test if unbalanced brac will lead to errors.
 func TestParseFilter_unbalancedbrac(t *testing.T) {
 	query := `
@@ -2779,6 +3033,93 @@ func TestParseGroupby(t *testing.T) {
 	require.Equal(t, "en", res.Query[0].Children[0].GroupbyAttrs[0].Langs[0])
 }
 
+func TestParseGroupbyDistribution(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(name@en) {
+				count(distinct age)
+				percentile(age, 0.5)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := res.Query[0].Children[0].Children
+	require.Equal(t, 2, len(children))
+	require.Equal(t, "count_distinct", children[0].Func.Name)
+	require.Equal(t, "age", children[0].Attr)
+	require.Equal(t, "percentile", children[1].Func.Name)
+	require.Equal(t, "age", children[1].Attr)
+	require.Equal(t, "0.5", children[1].Func.Args[0].Value)
+}
+
+func TestParseGroupbyPathHaving(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(works_at.city, orderdesc: count(uid), orderasc: avg(age), first: 5, offset: 1) @having(gt(count(uid), 10) and not lt(percentile(age, 0.9), -1.5)) {
+				count(uid)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	gq := res.Query[0].Children[0]
+	require.Equal(t, []GroupByAttr{{Attr: "works_at.city"}}, gq.GroupbyAttrs)
+	require.Equal(t, []GroupByOrder{
+		{Aggregate: &GroupAggregate{Name: "count", Attr: "uid"}, Desc: true},
+		{Aggregate: &GroupAggregate{Name: "avg", Attr: "age"}},
+	}, gq.GroupbyOrder)
+	require.Equal(t, 5, gq.GroupbyFirst)
+	require.Equal(t, 1, gq.GroupbyOffset)
+
+	require.Equal(t, "and", gq.Having.Op)
+	gt := gq.Having.Child[0].Func
+	require.Equal(t, "gt", gt.Name)
+	require.Equal(t, &GroupAggregate{Name: "count", Attr: "uid"}, gt.Aggregate)
+	require.Equal(t, []Arg{{Value: "10"}}, gt.Args)
+	require.Equal(t, "not", gq.Having.Child[1].Op)
+	lt := gq.Having.Child[1].Child[0].Func
+	require.Equal(t, "percentile", lt.Aggregate.Name)
+	require.Equal(t, []Arg{{Value: "0.9"}}, lt.Aggregate.Args)
+	require.Equal(t, []Arg{{Value: "-1.5"}}, lt.Args)
+}
+
+func TestParseGroupbyAliasFirst(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(first: name) {
+				count(uid)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	gq := res.Query[0].Children[0]
+	require.Equal(t, []GroupByAttr{{Attr: "name", Alias: "first"}}, gq.GroupbyAttrs)
+	require.Equal(t, 0, gq.GroupbyFirst)
+}
+
+func TestParseHavingError(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(city) @having(anyofterms(count(uid), 10)) {
+				count(uid)
+			}
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Only eq, le, lt, ge and gt are allowed in @having")
+}
+
 func TestParseGroupbyWithAlias(t *testing.T) {
 	query := `
 	query {
@@ -3394,6 +3735,137 @@ func TestParseQueryWithAttrLang2(t *testing.T) {
 	require.Equal(t, []string{"en"}, res.Query[0].Order[0].Langs)
 }
 
+func TestParseMatch(t *testing.T) {
+	query := `
+	{
+	  me(func:match(name@en, "Michone", 2)) {
+	    name
+		friend @filter(match(name, "Rik", 1)) {
+	      name
+	    }
+	  }
+    }
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.NotNil(t, res.Query)
+	require.Equal(t, 1, len(res.Query))
+	fn := res.Query[0].Func
+	require.Equal(t, "match", fn.Name)
+	require.Equal(t, "name", fn.Attr)
+	require.Equal(t, "en", fn.Lang)
+	require.Equal(t, "Michone", fn.Args[0].Value)
+	require.Equal(t, "2", fn.Args[1].Value)
+	require.Equal(t, "match", res.Query[0].Children[1].Filter.Func.Name)
+	require.Equal(t, "Rik", res.Query[0].Children[1].Filter.Func.Args[0].Value)
+}
+
+func TestParsePhrase(t *testing.T) {
+	query := `
+	{
+	  me(func: phrase(title@en, "new york")) {
+	    title
+		friend @filter(near_words(title, "new york", 3)) {
+	      title
+	    }
+	  }
+    }
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.NotNil(t, res.Query)
+	require.Equal(t, 1, len(res.Query))
+	fn := res.Query[0].Func
+	require.Equal(t, "phrase", fn.Name)
+	require.Equal(t, "title", fn.Attr)
+	require.Equal(t, "en", fn.Lang)
+	require.Equal(t, "new york", fn.Args[0].Value)
+	filter := res.Query[0].Children[1].Filter.Func
+	require.Equal(t, "near_words", filter.Name)
+	require.Equal(t, "new york", filter.Args[0].Value)
+	require.Equal(t, "3", filter.Args[1].Value)
+}
+
+func TestParsePrefix(t *testing.T) {
+	query := `
+	{
+	  me(func: prefix(name, "Ab"), first: 10) {
+	    name
+	    friend @filter(prefix(name, "Ca")) {
+	      name
+	    }
+	  }
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	fn := res.Query[0].Func
+	require.Equal(t, "prefix", fn.Name)
+	require.Equal(t, "name", fn.Attr)
+	require.Equal(t, "Ab", fn.Args[0].Value)
+	filter := res.Query[0].Children[1].Filter.Func
+	require.Equal(t, "prefix", filter.Name)
+	require.Equal(t, "Ca", filter.Args[0].Value)
+}
+
+func TestParseHighlight(t *testing.T) {
+	query := `
+	{
+	  me(func: anyofterms(title, "new york")) {
+	    title @highlight
+	    hl: description@en @highlight
+	    name
+	  }
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := res.Query[0].Children
+	require.Equal(t, 3, len(children))
+	require.Equal(t, "title", children[0].Attr)
+	require.True(t, children[0].Highlight)
+	require.Equal(t, "description", children[1].Attr)
+	require.Equal(t, "hl", children[1].Alias)
+	require.Equal(t, []string{"en"}, children[1].Langs)
+	require.True(t, children[1].Highlight)
+	require.False(t, children[2].Highlight)
+}
+
+func TestParseHighlight_Error(t *testing.T) {
+	query := `
+	{
+	  me(func: anyofterms(title, "new york")) {
+	    title @highlight @highlight
+	  }
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Only one highlight directive allowed")
+}
+
+func TestParseSimilarTo(t *testing.T) {
+	query := `
+	query test($vec: string) {
+	  d as var(func: similar_to(embedding, 3, $vec))
+
+	  me(func: uid(d), orderasc: val(d)) {
+	    name
+	    dist: val(d)
+	  }
+    }
+`
+	res, err := Parse(Request{Str: query, Variables: map[string]string{"$vec": "[0.1, 0.2]"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Query))
+	fn := res.Query[0].Func
+	require.Equal(t, "similar_to", fn.Name)
+	require.Equal(t, "embedding", fn.Attr)
+	require.Equal(t, "3", fn.Args[0].Value)
+	require.Equal(t, "[0.1, 0.2]", fn.Args[1].Value)
+	require.Equal(t, "d", res.Query[0].Var)
+}
+
 func TestParseRegexp1(t *testing.T) {
 	query := `
 	{
@@ -3837,6 +4309,51 @@ func TestAggRoot1(t *testing.T) {
 	require.Equal(t, true, gql.Query[1].IsEmpty)
 }
 
+func TestAggRootDistribution(t *testing.T) {
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				count(distinct val(a))
+				median(val(a))
+				percentile(val(a), 0.95)
+				stddev(val(a))
+				variance(val(a))
+			}
+		}
+	`
+	gql, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := gql.Query[1].Children
+	require.Equal(t, 5, len(children))
+	require.Equal(t, "count_distinct", children[0].Func.Name)
+	require.Equal(t, "a", children[0].NeedsVar[0].Name)
+	require.Equal(t, "median", children[1].Func.Name)
+	require.Equal(t, "percentile", children[2].Func.Name)
+	require.Equal(t, "0.95", children[2].Func.Args[0].Value)
+	require.Equal(t, "stddev", children[3].Func.Name)
+	require.Equal(t, "variance", children[4].Func.Name)
+}
+
+func TestAggRootPercentileError(t *testing.T) {
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				percentile(val(a), 95)
+			}
+		}
+	`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "between 0 and 1")
+}
 func TestAggRootError(t *testing.T) {
 	query := `
 		{
@@ -4064,6 +4581,41 @@ func TestInvalidValUsage(t *testing.T) {
 	require.Contains(t, err.Error(), "Query syntax invalid.")
 }
 
+func TestOrderNestedAndNulls(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1), orderasc: works_at.name@en, nulls: first, orderdesc: max(friend.age)) {
+				friend(orderdesc: min(scores), nulls: last, orderasc: first(~friend.name)) {
+					name
+				}
+			}
+		}
+	`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, []*intern.Order{
+		{Attr: "works_at.name", Langs: []string{"en"}, Nulls: "first"},
+		{Attr: "friend.age", Desc: true, Aggregate: "max"},
+	}, gq.Query[0].Order)
+	require.Equal(t, []*intern.Order{
+		{Attr: "scores", Desc: true, Aggregate: "min", Nulls: "last"},
+		{Attr: "~friend.name", Aggregate: "first"},
+	}, gq.Query[0].Children[0].Order)
+}
+
+func TestOrderNullsError(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1), nulls: first, orderasc: name) {
+				name
+			}
+		}
+	`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "nulls should follow orderasc or orderdesc")
+}
+
 func TestOrderWithLang(t *testing.T) {
 	query := `
 	{
@@ -4174,6 +4726,145 @@ func TestParseMutation(t *testing.T) {
 
 }
 
+func TestParseUpsert(t *testing.T) {
+	m := `
+		upsert {
+			query {
+				me(func: eq(email, "a@b.com")) {
+					u as uid
+					n as name
+				}
+			}
+			mutation {
+				set {
+					uid(u) <email> "a@b.com" .
+					uid(u) <nick> val(n) .
+				}
+			}
+		}
+	`
+	require.True(t, IsUpsert(m))
+	up, err := ParseUpsert(m)
+	require.NoError(t, err)
+	require.Contains(t, up.Query, `eq(email, "a@b.com")`)
+	sets, err := parseNquads(up.Mutation.SetNquads)
+	require.NoError(t, err)
+	require.EqualValues(t, &api.NQuad{Subject: "uid(u)", Predicate: "email",
+		ObjectValue: &api.Value{&api.Value_DefaultVal{"a@b.com"}}}, sets[0])
+	require.EqualValues(t, &api.NQuad{Subject: "uid(u)", Predicate: "nick",
+		ObjectId: "val(n)"}, sets[1])
+
+	gmu := Mutation{Set: sets}
+	require.Equal(t, []string{"n", "u"}, gmu.NeedVars())
+
+	res, err := ParseWithNeedVars(Request{Str: up.Query}, gmu.NeedVars())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Query))
+}
+
+func TestParseUpsertError(t *testing.T) {
+	for _, m := range []string{
+		`upsert { mutation { set { <a> <b> <c> . } } }`,
+		`upsert { query { me(func: uid(1)) { uid } } }`,
+		`upsert { query { me(func: uid(1)) { uid } } mutation { set { <a> <b> <c> . } }`,
+		`upsert { quer { me(func: uid(1)) { uid } } mutation { set { <a> <b> <c> . } } }`,
+		`upsertx { query { me(func: uid(1)) { uid } } mutation { set { <a> <b> <c> . } } }`,
+		`upsert { query { me(func: uid(1)) { uid } } mutation { set { <a> <b> <c> . } } } }`,
+		`upsert { query { me(func: uid(1)) { uid } } query { me(func: uid(2)) { uid } }
+			mutation { set { <a> <b> <c> . } } }`,
+	} {
+		_, err := ParseUpsert(m)
+		require.Error(t, err, m)
+	}
+
+	require.False(t, IsUpsert(`upsertx { query { me(func: uid(1)) { uid } } }`))
+	require.False(t, IsUpsert(`{ set { <upsert> <b> <c> . } }`))
+
+	// Variables used in the mutation must be defined in the query.
+	_, err := ParseWithNeedVars(Request{Str: `{ me(func: uid(1)) { uid } }`}, []string{"u"})
+	require.Error(t, err)
+}
+
+func TestParseUpsertBracketsInStrings(t *testing.T) {
+	m := `upsert {
+		query {
+			me(func: eq(name, "a } b")) {
+				u as uid
+			}
+		}
+		mutation {
+			set {
+				uid(u) <name> "c { d" .
+				uid(u) <nick> "val(u)" .
+			}
+		}
+	}`
+	up, err := ParseUpsert(m)
+	require.NoError(t, err)
+	require.Contains(t, up.Query, `eq(name, "a } b")`)
+	sets, err := parseNquads(up.Mutation.SetNquads)
+	require.NoError(t, err)
+	require.EqualValues(t, &api.NQuad{Subject: "uid(u)", Predicate: "name",
+		ObjectValue: &api.Value{&api.Value_DefaultVal{"c { d"}}}, sets[0])
+	// A quoted val(v) is a plain string and isn't taken for a variable.
+	require.EqualValues(t, &api.NQuad{Subject: "uid(u)", Predicate: "nick",
+		ObjectValue: &api.Value{&api.Value_DefaultVal{"val(u)"}}}, sets[1])
+	require.Equal(t, []string{"u"}, Mutation{Set: sets}.NeedVars())
+}
+
+func TestParseUpsertWithCond(t *testing.T) {
+	m := `
+		upsert {
+			query {
+				me(func: eq(email, "a@b.com")) {
+					u as uid
+				}
+			}
+			mutation @if(eq(len(u), 0)) {
+				set {
+					_:new <email> "a@b.com" .
+				}
+			}
+		}
+	`
+	up, err := ParseUpsert(m)
+	require.NoError(t, err)
+	require.Equal(t, "eq(len(u), 0)", up.Cond)
+	require.NotNil(t, up.Mutation)
+
+	cond, err := ParseCond(up.Cond)
+	require.NoError(t, err)
+	require.Equal(t, "eq", cond.Func.Name)
+	require.Equal(t, "u", cond.Func.Attr)
+	require.True(t, cond.Func.IsLenVar)
+	require.Equal(t, []Arg{{Value: "0"}}, cond.Func.Args)
+	require.Equal(t, []string{"u"}, cond.NeedVars())
+}
+
+func TestParseCond(t *testing.T) {
+	cond, err := ParseCond("gt(len(v), 1) and not (eq(len(u), 0) or le(len(w), 3))")
+	require.NoError(t, err)
+	require.Equal(t, `(AND (gt len(v) "1") (NOT (OR (eq len(u) "0") (le len(w) "3"))))`,
+		cond.debugString())
+	require.Equal(t, []string{"u", "v", "w"}, cond.NeedVars())
+
+	for _, c := range []string{
+		"",
+		"eq(name, 0)",
+		"eq(len(u), \"a\")",
+		"eq(len(u), 0, 1)",
+		"anyofterms(len(u), 0)",
+		"eq(len(u), 0))",
+	} {
+		_, err := ParseCond(c)
+		require.Error(t, err, c)
+	}
+
+	_, err = ParseUpsert(`upsert { query { me(func: uid(1)) { u as uid } }
+		mutation @iff(eq(len(u), 0)) { set { <a> <b> <c> . } } }`)
+	require.Error(t, err)
+}
+
 func TestParseMissingGraphQLVar(t *testing.T) {
 	for _, q := range []string{
 		"{ q(func: eq(name, $a)) { name }}",
@@ -4257,3 +4948,46 @@ func TestParseGraphQLVarPaginationRootMultiple(t *testing.T) {
 	require.Equal(t, args["after"], "0x123")
 	require.Equal(t, gq.Query[0].Order[0].Attr, "name")
 }
+
+func TestParseTerms(t *testing.T) {
+	query := `
+	{
+		people as var(func: has(name))
+		countries(func: terms(country, uid(people), first: 50, orderasc: term)) {
+		}
+		all(func: terms(country)) {
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "country", res.Query[1].Func.Attr)
+	require.Equal(t, []Arg{{Value: "term"}, {Value: "asc"}, {Value: "50"}, {Value: "0"}},
+		res.Query[1].Func.Args)
+	require.Equal(t, []VarContext{{Name: "people", Typ: UID_VAR}}, res.Query[1].NeedsVar)
+	require.Equal(t, []Arg{{Value: "count"}, {Value: "desc"}, {Value: "0"}, {Value: "0"}},
+		res.Query[2].Func.Args)
+}
+
+func TestParseTermsError(t *testing.T) {
+	query := `
+	{
+		countries(func: terms(country, orderdesc: name)) {
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "terms can only be ordered by count or term")
+
+	query = `
+	{
+		me(func: has(name)) @filter(terms(country)) {
+			name
+		}
+	}
+`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "terms() can only be used at root")
+}
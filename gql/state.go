@@ -54,6 +54,7 @@ const (
 	itemRightSquare
 	itemComma
 	itemMathOp
+	itemUpsertBlockContent // content of a block or of the @if directive inside upsert
 )
 
 func lexInsideMutation(l *lex.Lexer) lex.StateFn {
@@ -86,6 +87,65 @@ func lexInsideMutation(l *lex.Lexer) lex.StateFn {
 	}
 }
 
+// lexUpsertBlock lexes an upsert block. The contents of its query and mutation blocks, and the
+// condition of the @if directive, are each emitted as one item, to be parsed on their own.
+func lexUpsertBlock(l *lex.Lexer) lex.StateFn {
+	l.Mode = lexUpsertBlock
+	for {
+		switch r := l.Next(); {
+		case r == leftCurl && l.Depth == 0:
+			l.Depth++
+			l.Emit(itemLeftCurl)
+		case r == leftCurl:
+			return lexUpsertBlockContent(l, leftCurl, rightCurl)
+		case r == leftRound:
+			return lexUpsertBlockContent(l, leftRound, rightRound)
+		case r == rightCurl:
+			if l.Depth == 0 {
+				return l.Errorf("Too many right curl")
+			}
+			l.Depth--
+			l.Emit(itemRightCurl)
+			return lexTopLevel
+		case r == at:
+			l.Emit(itemAt)
+			return lexDirectiveOrLangList
+		case isSpace(r) || isEndOfLine(r):
+			l.Ignore()
+		case isNameBegin(r):
+			return lexName
+		case r == '#':
+			return lexComment
+		case r == lex.EOF:
+			return l.Errorf("Unclosed upsert block")
+		default:
+			return l.Errorf("Unrecognized character inside upsert block: %#U", r)
+		}
+	}
+}
+
+// lexUpsertBlockContent absorbs the text up to the bracket matching the one just read, ignoring
+// the brackets inside quoted strings.
+func lexUpsertBlockContent(l *lex.Lexer, left, right rune) lex.StateFn {
+	depth := 1
+	for depth > 0 {
+		switch l.Next() {
+		case lex.EOF:
+			return l.Errorf("Unclosed block inside upsert block")
+		case quote:
+			if err := l.LexQuotedString(); err != nil {
+				return l.Errorf(err.Error())
+			}
+		case left:
+			depth++
+		case right:
+			depth--
+		}
+	}
+	l.Emit(itemUpsertBlockContent)
+	return lexUpsertBlock
+}
+
 func lexInsideSchema(l *lex.Lexer) lex.StateFn {
 	l.Mode = lexInsideSchema
 	for {
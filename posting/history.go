@@ -0,0 +1,95 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package posting
+
+import (
+	"bytes"
+	"math"
+	"sync/atomic"
+
+	"github.com/dgraph-io/badger"
+)
+
+// historyTs is the lowest timestamp posting lists can be read at. The versions needed to read
+// them at it, or later, aren't discarded on disk. Unless history is retained it's MaxUint64,
+// so that only the latest version of a posting list is kept.
+var historyTs uint64 = math.MaxUint64
+
+// SetHistoryTs sets the lowest timestamp posting lists can be read at. Versions written before
+// it are discarded once the following rollups have called discardHistory.
+func SetHistoryTs(ts uint64) {
+	atomic.StoreUint64(&historyTs, ts)
+}
+
+func HistoryTs() uint64 {
+	return atomic.LoadUint64(&historyTs)
+}
+
+// readAt reads the posting list for key as it was at readTs from disk.
+func readAt(key []byte, readTs uint64) (*List, error) {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	it := txn.NewIterator(iterOpts)
+	defer it.Close()
+	it.Seek(key)
+	return ReadPostingList(key, it)
+}
+
+// discardHistory writes the posting list for key as it was at HistoryTs as a complete list at
+// HistoryTs, marked so that Badger discards the versions before it. Reads at HistoryTs or later
+// see the same postings as before.
+func discardHistory(key []byte) error {
+	historyTs := HistoryTs()
+	txn := pstore.NewTransactionAt(historyTs, false)
+	defer txn.Discard()
+
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.AllVersions = true
+	it := txn.NewIterator(iterOpts)
+	defer it.Close()
+	it.Seek(key)
+	if !it.Valid() || !bytes.Equal(it.Item().Key(), key) {
+		// Nothing was written before historyTs.
+		return nil
+	}
+	if item := it.Item(); item.IsDeletedOrExpired() {
+		return nil
+	} else if item.UserMeta()&BitCompletePosting > 0 {
+		if item.DiscardEarlierVersions() {
+			return nil
+		}
+		// A complete list which is the only version left has nothing to discard.
+		it.Next()
+		if !it.Valid() || !bytes.Equal(it.Item().Key(), key) {
+			return nil
+		}
+		it.Seek(key)
+	}
+
+	l, err := ReadPostingList(key, it)
+	if err != nil {
+		return err
+	}
+	l.Lock()
+	err = l.rollup()
+	data, meta := marshalPostingList(l.plist)
+	l.Unlock()
+	if err != nil {
+		return err
+	}
+
+	wtxn := pstore.NewTransactionAt(historyTs, true)
+	defer wtxn.Discard()
+	if err := wtxn.SetWithDiscard(key, data, meta); err != nil {
+		return err
+	}
+	return wtxn.CommitAt(historyTs, nil)
+}
@@ -10,6 +10,7 @@ package posting
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"sync"
@@ -92,6 +93,19 @@ func (txn *Txn) addIndexMutations(ctx context.Context, t *intern.DirectedEdge, p
 		return err
 	}
 
+	textTokens, hasFullText, err := fullTextTokens(attr, t.GetLang(), p)
+	if err != nil {
+		return err
+	}
+	var positions map[string][]uint32
+	singleValue := !schema.State().IsList(attr) && !schema.State().HasLang(attr)
+	if singleValue {
+		// The values of list and language tagged predicates share their token postings, so
+		// positions recorded for one value would overwrite the ones of another. phrase() and
+		// near_words() tokenize the values again for them instead.
+		positions = tok.PositionsByToken(textTokens)
+	}
+
 	// Create a value token -> uid edge.
 	edge := &intern.DirectedEdge{
 		ValueId: uid,
@@ -100,13 +114,146 @@ func (txn *Txn) addIndexMutations(ctx context.Context, t *intern.DirectedEdge, p
 	}
 
 	for _, token := range tokens {
-		if err := txn.addIndexMutation(ctx, edge, token); err != nil {
+		e := edge
+		if pos, ok := positions[token]; ok && op == intern.DirectedEdge_SET {
+			// Full text tokens also carry the positions at which they occur in the value, to
+			// evaluate phrase and proximity searches.
+			e = &intern.DirectedEdge{
+				ValueId:   uid,
+				Attr:      attr,
+				Op:        op,
+				Positions: tok.EncodePositions(pos),
+			}
+		}
+		if err := txn.addIndexMutation(ctx, e, token); err != nil {
+			return err
+		}
+	}
+
+	if hasFullText && singleValue {
+		// The number of words of the value is kept for relevance scoring. As with positions,
+		// it's only kept for predicates with a single value per node.
+		e := &intern.DirectedEdge{
+			ValueId: uid,
+			Attr:    attr,
+			Op:      op,
+			Value:   x.AppendUvarint(nil, uint64(len(textTokens))),
+		}
+		if err := txn.addIndexMutation(ctx, e, tok.FullTextLengthsToken()); err != nil {
+			return err
+		}
+		if err := txn.updateFullTextStats(ctx, attr, len(textTokens), op); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// fullTextStatsUid is the uid of the posting in the lengths list of a full text index which keeps
+// the total number of words of the values indexed and the number of values, for their average
+// length. Uids are never handed out this high.
+const fullTextStatsUid = math.MaxUint64
+
+// fullTextStats returns the total number of words of the values whose lengths are in the list,
+// and the number of values, as of readTs. It returns false if they weren't kept, as for indices
+// built by the bulk loader, in which case they're counted from the lengths.
+func (l *List) fullTextStats(readTs uint64) (int, int, bool, error) {
+	l.AssertRLock()
+	var words, docs uint64
+	var kept bool
+	err := l.iterate(readTs, fullTextStatsUid-1, func(p *intern.Posting) bool {
+		var n int
+		if words, n = binary.Uvarint(p.Value); n > 0 {
+			docs, _ = binary.Uvarint(p.Value[n:])
+			kept = true
+		}
+		return false
+	})
+	if err != nil || kept {
+		return int(words), int(docs), kept, err
+	}
+	err = l.iterate(readTs, 0, func(p *intern.Posting) bool {
+		if p.Uid == fullTextStatsUid {
+			return false
+		}
+		if length, n := binary.Uvarint(p.Value); n > 0 {
+			words += length
+			docs++
+		}
+		return true
+	})
+	return int(words), int(docs), false, err
+}
+
+// FullTextStats returns the total number of words of the values in the lengths list of a full
+// text index, and the number of values, as of readTs.
+func (l *List) FullTextStats(readTs uint64) (int, int, error) {
+	l.RLock()
+	defer l.RUnlock()
+	words, docs, _, err := l.fullTextStats(readTs)
+	return words, docs, err
+}
+
+// updateFullTextStats adds a value of the given number of words to the totals kept in the
+// lengths list of the full text index of attr, or removes it. Index keys aren't checked for
+// conflicts, so concurrent transactions can overwrite each other's totals; they only serve for
+// an average.
+func (txn *Txn) updateFullTextStats(ctx context.Context, attr string, words int,
+	op intern.DirectedEdge_Op) error {
+	plist, err := Get(x.IndexKey(attr, tok.FullTextLengthsToken()))
+	if err != nil {
+		return err
+	}
+	plist.Lock()
+	defer plist.Unlock()
+	total, docs, kept, err := plist.fullTextStats(txn.StartTs)
+	if err != nil {
+		return err
+	}
+	// Unless they were kept, the totals were just counted from the lengths, which include the
+	// change already.
+	if kept && op == intern.DirectedEdge_SET {
+		total, docs = total+words, docs+1
+	} else if kept {
+		total, docs = total-words, docs-1
+	}
+	if total < 0 || docs < 0 {
+		total, docs = 0, 0
+	}
+	e := &intern.DirectedEdge{
+		ValueId: fullTextStatsUid,
+		Attr:    attr,
+		Op:      intern.DirectedEdge_SET,
+		Value:   x.AppendUvarint(x.AppendUvarint(nil, uint64(total)), uint64(docs)),
+	}
+	_, err = plist.addMutation(ctx, txn, e)
+	return err
+}
+
+// fullTextTokens returns the tokens of the full text index in the value, with their positions.
+// It returns false if attr doesn't have a full text index.
+func fullTextTokens(attr, lang string, src types.Val) ([]tok.TextToken, bool, error) {
+	for _, it := range schema.State().Tokenizer(attr) {
+		if it.Identifier() != (tok.FullTextTokenizer{}).Identifier() {
+			continue
+		}
+		if tok.FtsTokenizerName("") == it.Name() && len(lang) > 0 {
+			newTokenizer, ok := tok.GetTokenizer(tok.FtsTokenizerName(lang))
+			if !ok {
+				return nil, false, x.Errorf("Tokenizer not available for language: %s", lang)
+			}
+			it = newTokenizer
+		}
+		sv, err := types.Convert(src, types.StringID)
+		if err != nil {
+			return nil, false, err
+		}
+		tokens, err := tok.TokenPositions(it, sv.Value.(string))
+		return tokens, true, err
+	}
+	return nil, false, nil
+}
+
 func (txn *Txn) addIndexMutation(ctx context.Context, edge *intern.DirectedEdge,
 	token string) error {
 	key := x.IndexKey(edge.Attr, token)
@@ -922,8 +1069,8 @@ func DeleteAll() error {
 		pk := x.Parse(key)
 		if pk == nil {
 			return true
-		} else if pk.IsSchema() && pk.Attr == x.PredicateListAttr {
-			// Don't delete schema for _predicate_
+		} else if pk.IsSchema() && (pk.Attr == x.PredicateListAttr || pk.Attr == x.TypeAttr) {
+			// Don't delete schema for _predicate_ and _type_
 			return false
 		}
 		return true
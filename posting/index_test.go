@@ -19,6 +19,7 @@ import (
 
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
@@ -345,3 +346,33 @@ func TestRebuildReverseEdges(t *testing.T) {
 	require.EqualValues(t, 2, uids0[1])
 	require.EqualValues(t, 1, uids1[0])
 }
+
+func TestFullTextPositions(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		title: string @index(fulltext) .
+		titles: [string] @index(fulltext) .`), 1))
+	for _, attr := range []string{"title", "titles"} {
+		l, err := Get(x.DataKey(attr, 1))
+		require.NoError(t, err)
+		edge := &intern.DirectedEdge{Value: []byte("quick brown fox"), Attr: attr, Entity: 1}
+		addMutation(t, l, edge, Set, 5, 6, true)
+	}
+
+	ft, ok := tok.GetTokenizer("fulltext")
+	require.True(t, ok)
+	tokens, err := tok.TokenPositions(ft, "quick brown fox")
+	require.NoError(t, err)
+	positions := func(attr string) []byte {
+		l, err := Get(x.IndexKey(attr, tokens[1].Token))
+		require.NoError(t, err)
+		var pos []byte
+		require.NoError(t, l.Postings(ListOptions{ReadTs: 7}, func(p *intern.Posting) bool {
+			pos = p.Positions
+			return true
+		}))
+		return pos
+	}
+	require.Equal(t, tok.EncodePositions([]uint32{tokens[1].Pos}), positions("title"))
+	// Positions aren't recorded for list predicates, whose values share the postings.
+	require.Empty(t, positions("titles"))
+}
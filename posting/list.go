@@ -153,7 +153,7 @@ type ListOptions struct {
 }
 
 // samePosting tells whether this is same posting depending upon operation of new posting.
-// if operation is Del, we ignore facets and only care about uid and value.
+// if operation is Del, we ignore facets and positions and only care about uid and value.
 // otherwise we match everything.
 func samePosting(oldp *intern.Posting, newp *intern.Posting) bool {
 	if oldp.Uid != newp.Uid {
@@ -179,6 +179,9 @@ func samePosting(oldp *intern.Posting, newp *intern.Posting) bool {
 	if newp.Op == Del {
 		return true
 	}
+	if !bytes.Equal(oldp.Positions, newp.Positions) {
+		return false
+	}
 	return facets.SameFacets(oldp.Facets, newp.Facets)
 }
 
@@ -210,6 +213,7 @@ func NewPosting(t *intern.DirectedEdge) *intern.Posting {
 		Label:       t.Label,
 		Op:          op,
 		Facets:      t.Facets,
+		Positions:   t.Positions,
 	}
 }
 
@@ -538,6 +542,19 @@ func (l *List) inSnapshot(mpost *intern.Posting, readTs, deleteTs uint64) bool {
 
 func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *intern.Posting) bool) error {
 	l.AssertRLock()
+	if readTs < l.minTs {
+		if readTs < HistoryTs() {
+			return x.Errorf("readTs: %d less than minTs: %d for key: %q", readTs, l.minTs, l.key)
+		}
+		// The immutable layer has commits after readTs, so read the list as it was from disk.
+		hl, err := readAt(l.key, readTs)
+		if err != nil {
+			return err
+		}
+		hl.RLock()
+		defer hl.RUnlock()
+		return hl.iterate(readTs, afterUid, f)
+	}
 	midx := 0
 	var deleteTs uint64
 	if l.markdeleteAll == 0 {
@@ -552,9 +569,6 @@ func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *intern.Postin
 			deleteTs = ts
 		}
 	}
-	if readTs < l.minTs {
-		return x.Errorf("readTs: %d less than minTs: %d for key: %q", readTs, l.minTs, l.key)
-	}
 	mlayerLen := len(l.mlayer)
 	if afterUid > 0 {
 		midx = sort.Search(mlayerLen, func(idx int) bool {
@@ -642,7 +656,12 @@ func (l *List) Length(readTs, afterUid uint64) int {
 func doAsyncWrite(commitTs uint64, key []byte, data []byte, meta byte, f func(error)) {
 	txn := pstore.NewTransactionAt(commitTs, true)
 	defer txn.Discard()
-	if err := txn.SetWithDiscard(key, data, meta); err != nil {
+	set := txn.SetWithDiscard
+	if commitTs > HistoryTs() {
+		// Reads at timestamps before commitTs need the earlier versions.
+		set = txn.SetWithMeta
+	}
+	if err := set(key, data, meta); err != nil {
 		f(err)
 	}
 	if err := txn.CommitAt(commitTs, f); err != nil {
@@ -710,8 +729,9 @@ func (l *List) rollup() error {
 			buf = buf[:0]
 		}
 
-		// We want to add the posting if it has facets or has a value.
-		if p.Facets != nil || p.PostingType != intern.Posting_REF || len(p.Label) != 0 {
+		// We want to add the posting if it has facets, positions or has a value.
+		if p.Facets != nil || p.PostingType != intern.Posting_REF || len(p.Label) != 0 ||
+			len(p.Positions) != 0 || len(p.Value) != 0 {
 			// I think it's okay to take the pointer from the iterator, because we have a lock
 			// over List; which won't be released until final has been marshalled. Thus, the
 			// underlying data wouldn't be changed.
@@ -810,6 +830,13 @@ func (l *List) syncIfDirty(delFromCache bool) (committed bool, err error) {
 		}
 		x.BytesWrite.Add(int64(len(data)))
 		x.PostingWrites.Add(1)
+		if minTs > HistoryTs() {
+			// The versions before minTs were kept for reads in the history window. Those
+			// which have since left it can go.
+			if err := discardHistory(l.key); err != nil {
+				x.Printf("Error while discarding history of key: %s, err: %+v", l.key, err)
+			}
+		}
 		if delFromCache {
 			x.AssertTrue(atomic.LoadInt32(&l.deleteMe) == 1)
 			lcache.delete(l.key)
@@ -840,11 +867,7 @@ func (l *List) Uids(opt ListOptions) (*intern.List, error) {
 	// Use approximate length for initial capacity.
 	res := make([]uint64, 0, len(l.mlayer)+bp128.NumIntegers(l.plist.Uids))
 	out := &intern.List{}
-	if len(l.mlayer) == 0 && opt.Intersect != nil {
-		if opt.ReadTs < l.minTs {
-			l.RUnlock()
-			return out, ErrTsTooOld
-		}
+	if len(l.mlayer) == 0 && opt.Intersect != nil && opt.ReadTs >= l.minTs {
 		algo.IntersectCompressedWith(l.plist.Uids, opt.AfterUID, opt.Intersect, out)
 		l.RUnlock()
 		return out, nil
@@ -8,6 +8,7 @@
 package posting
 
 import (
+	"bytes"
 	"context"
 	"io/ioutil"
 	"math"
@@ -15,6 +16,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/badger"
 	"github.com/stretchr/testify/require"
@@ -775,6 +777,111 @@ func TestAfterUIDCountWithCommit(t *testing.T) {
 	require.EqualValues(t, 0, ol.Length(txn.StartTs, 300))
 }
 
+func TestIterateHistory(t *testing.T) {
+	key := x.DataKey("history", 1)
+	ol, err := getNew(key, ps)
+	require.NoError(t, err)
+	waitForVersion := func(version uint64) {
+		for i := 0; i < 100; i++ {
+			txn := ps.NewTransactionAt(math.MaxUint64, false)
+			item, err := txn.Get(key)
+			txn.Discard()
+			if err == nil && item.Version() == version {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("Version %d of %q wasn't written", version, key)
+	}
+
+	edge := &intern.DirectedEdge{ValueId: 5}
+	addMutationHelper(t, ol, edge, Set, &Txn{StartTs: 1})
+	ol.CommitMutation(context.Background(), 1, 2)
+	_, err = ol.SyncIfDirty(false)
+	require.NoError(t, err)
+	waitForVersion(2)
+
+	txn := &Txn{StartTs: 3}
+	addMutationHelper(t, ol, &intern.DirectedEdge{ValueId: 5}, Del, txn)
+	addMutationHelper(t, ol, &intern.DirectedEdge{ValueId: 7}, Set, txn)
+	ol.CommitMutation(context.Background(), 3, 4)
+	_, err = ol.SyncIfDirty(false)
+	require.NoError(t, err)
+	waitForVersion(4)
+
+	// Without history, the list can't be read before its last rollup.
+	require.Error(t, ol.Iterate(3, 0, func(p *intern.Posting) bool { return true }))
+
+	SetHistoryTs(0)
+	defer SetHistoryTs(math.MaxUint64)
+	require.Equal(t, []uint64{5}, listToArray(t, 0, ol, 3))
+	require.Equal(t, []uint64{7}, listToArray(t, 0, ol, 4))
+	require.Empty(t, listToArray(t, 0, ol, 1))
+}
+
+func TestDiscardHistory(t *testing.T) {
+	SetHistoryTs(0)
+	defer SetHistoryTs(math.MaxUint64)
+
+	key := x.DataKey("history_discard", 1)
+	ol, err := getNew(key, ps)
+	require.NoError(t, err)
+	// versionAt returns the latest version of key at readTs.
+	versionAt := func(readTs uint64) *badger.Item {
+		txn := ps.NewTransactionAt(readTs, false)
+		defer txn.Discard()
+		iterOpts := badger.DefaultIteratorOptions
+		iterOpts.AllVersions = true
+		it := txn.NewIterator(iterOpts)
+		defer it.Close()
+		it.Seek(key)
+		if !it.Valid() || !bytes.Equal(it.Item().Key(), key) {
+			return nil
+		}
+		return it.Item()
+	}
+	waitForVersion := func(version uint64) *badger.Item {
+		for i := 0; i < 100; i++ {
+			if item := versionAt(version); item != nil && item.Version() == version {
+				return item
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("Version %d of %q wasn't written", version, key)
+		return nil
+	}
+
+	addMutationHelper(t, ol, &intern.DirectedEdge{ValueId: 5}, Set, &Txn{StartTs: 1})
+	ol.CommitMutation(context.Background(), 1, 2)
+	_, err = ol.SyncIfDirty(false)
+	require.NoError(t, err)
+	waitForVersion(2)
+
+	txn := &Txn{StartTs: 3}
+	addMutationHelper(t, ol, &intern.DirectedEdge{ValueId: 5}, Del, txn)
+	addMutationHelper(t, ol, &intern.DirectedEdge{ValueId: 7}, Set, txn)
+	ol.CommitMutation(context.Background(), 3, 4)
+	_, err = ol.SyncIfDirty(false)
+	require.NoError(t, err)
+	// Everything is in the history window, so nothing can be discarded.
+	require.False(t, waitForVersion(4).DiscardEarlierVersions())
+	require.False(t, versionAt(3).DiscardEarlierVersions())
+
+	SetHistoryTs(5)
+	addMutationHelper(t, ol, &intern.DirectedEdge{ValueId: 9}, Set, &Txn{StartTs: 6})
+	ol.CommitMutation(context.Background(), 6, 7)
+	_, err = ol.SyncIfDirty(false)
+	require.NoError(t, err)
+	require.False(t, waitForVersion(7).DiscardEarlierVersions())
+
+	// The list as it was at 5 is written at 5, and the versions before it are discarded.
+	item := waitForVersion(5)
+	require.True(t, item.DiscardEarlierVersions())
+	require.True(t, item.UserMeta()&BitCompletePosting > 0)
+	require.Equal(t, []uint64{7}, listToArray(t, 0, ol, 5))
+	require.Equal(t, []uint64{7, 9}, listToArray(t, 0, ol, 7))
+}
+
 var ps *badger.ManagedDB
 
 func TestMain(m *testing.M) {
@@ -146,6 +146,25 @@ func (t *Txn) conflicts(ok func(key []byte) bool) bool {
 	return false
 }
 
+// Predicates returns the predicates of the keys the transaction wrote to.
+func (t *Txn) Predicates() []string {
+	t.Lock()
+	defer t.Unlock()
+	seen := make(map[string]struct{})
+	var preds []string
+	for _, d := range t.deltas {
+		pk := x.Parse(d.key)
+		if pk == nil {
+			continue
+		}
+		if _, ok := seen[pk.Attr]; !ok {
+			seen[pk.Attr] = struct{}{}
+			preds = append(preds, pk.Attr)
+		}
+	}
+	return preds
+}
+
 func (t *transactions) Get(startTs uint64) *Txn {
 	t.RLock()
 	defer t.RUnlock()
@@ -37,12 +37,80 @@ type oracle struct {
 	// Used for waiting logic for transactions with startTs > maxpending so that we don't read an
 	// uncommitted transaction.
 	waiters map[uint64][]chan struct{}
+
+	// Functions called with the commits of each oracle delta, see WatchCommits.
+	watchers    map[int]func(CommitEvent)
+	nextWatcher int
+}
+
+// CommitEvent tells which predicates the transactions committed by an oracle delta wrote to.
+type CommitEvent struct {
+	Preds map[string]struct{}
+	// Unknown is set if some of the transactions weren't applied on this server, so that the
+	// predicates they wrote to aren't known.
+	Unknown bool
+}
+
+// Touches tells whether the transactions might have written to any of the predicates.
+func (e CommitEvent) Touches(preds []string) bool {
+	if e.Unknown {
+		return true
+	}
+	for _, pred := range preds {
+		if _, ok := e.Preds[pred]; ok {
+			return true
+		}
+	}
+	return false
 }
 
 func (o *oracle) init() {
 	o.commits = make(map[uint64]uint64)
 	o.aborts = make(map[uint64]struct{})
 	o.waiters = make(map[uint64][]chan struct{})
+	o.watchers = make(map[int]func(CommitEvent))
+}
+
+// WatchCommits calls f with the commits of each oracle delta received from Zero, until the
+// returned function is called. f must not block.
+func (o *oracle) WatchCommits(f func(CommitEvent)) func() {
+	o.Lock()
+	defer o.Unlock()
+	id := o.nextWatcher
+	o.nextWatcher++
+	o.watchers[id] = f
+	return func() {
+		o.Lock()
+		defer o.Unlock()
+		delete(o.watchers, id)
+	}
+}
+
+func (o *oracle) notifyCommits(od *intern.OracleDelta) {
+	o.RLock()
+	watchers := make([]func(CommitEvent), 0, len(o.watchers))
+	for _, f := range o.watchers {
+		watchers = append(watchers, f)
+	}
+	o.RUnlock()
+	if len(watchers) == 0 {
+		return
+	}
+
+	e := CommitEvent{Preds: make(map[string]struct{})}
+	for startTs := range od.Commits {
+		txn := Txns().Get(startTs)
+		if txn == nil {
+			e.Unknown = true
+			continue
+		}
+		for _, pred := range txn.Predicates() {
+			e.Preds[pred] = struct{}{}
+		}
+	}
+	for _, f := range watchers {
+		f(e)
+	}
 }
 
 func (o *oracle) Done(startTs uint64) {
@@ -116,6 +184,13 @@ func (o *oracle) WaitForTs(ctx context.Context, startTs uint64) error {
 }
 
 func (o *oracle) ProcessOracleDelta(od *intern.OracleDelta) {
+	o.processDelta(od)
+	if len(od.Commits) > 0 {
+		o.notifyCommits(od)
+	}
+}
+
+func (o *oracle) processDelta(od *intern.OracleDelta) {
 	o.Lock()
 	defer o.Unlock()
 	for startTs, commitTs := range od.Commits {
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package posting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestWatchCommits(t *testing.T) {
+	txn := Txns().PutOrMergeIndex(&Txn{StartTs: 300, Indices: []uint64{1}})
+	txn.AddDelta(x.DataKey("name", 1), &intern.Posting{}, false)
+	txn.AddDelta(x.IndexKey("name", "\x01alice"), &intern.Posting{}, false)
+	txn.AddDelta(x.DataKey("friend", 1), &intern.Posting{}, false)
+	require.Equal(t, []string{"name", "friend"}, txn.Predicates())
+
+	var events []CommitEvent
+	stop := Oracle().WatchCommits(func(e CommitEvent) {
+		events = append(events, e)
+	})
+	Oracle().ProcessOracleDelta(&intern.OracleDelta{Commits: map[uint64]uint64{300: 301}})
+	// The transaction wasn't applied on this server.
+	Oracle().ProcessOracleDelta(&intern.OracleDelta{Commits: map[uint64]uint64{310: 311}})
+	Oracle().ProcessOracleDelta(&intern.OracleDelta{Aborts: []uint64{315}})
+	stop()
+	Oracle().ProcessOracleDelta(&intern.OracleDelta{Commits: map[uint64]uint64{320: 321}})
+
+	require.Equal(t, 2, len(events))
+	require.False(t, events[0].Unknown)
+	require.True(t, events[0].Touches([]string{"age", "name"}))
+	require.False(t, events[0].Touches([]string{"age"}))
+	require.True(t, events[1].Unknown)
+	require.True(t, events[1].Touches([]string{"age"}))
+}
@@ -51,6 +51,13 @@
 		PeerResponse
 		Num
 		SnapshotMeta
+		TypeUpdate
+		AnalyzerConfig
+		ChangeEvent
+		ChangesRequest
+		Time
+		SortCursor
+		TimeMark
 */
 package intern
 
@@ -111,19 +118,21 @@ const (
 	Posting_UID      Posting_ValType = 7
 	Posting_PASSWORD Posting_ValType = 8
 	Posting_STRING   Posting_ValType = 9
+	Posting_VFLOAT   Posting_ValType = 10
 )
 
 var Posting_ValType_name = map[int32]string{
-	0: "DEFAULT",
-	1: "BINARY",
-	2: "INT",
-	3: "FLOAT",
-	4: "BOOL",
-	5: "DATETIME",
-	6: "GEO",
-	7: "UID",
-	8: "PASSWORD",
-	9: "STRING",
+	0:  "DEFAULT",
+	1:  "BINARY",
+	2:  "INT",
+	3:  "FLOAT",
+	4:  "BOOL",
+	5:  "DATETIME",
+	6:  "GEO",
+	7:  "UID",
+	8:  "PASSWORD",
+	9:  "STRING",
+	10: "VFLOAT",
 }
 var Posting_ValType_value = map[string]int32{
 	"DEFAULT":  0,
@@ -136,6 +145,7 @@ var Posting_ValType_value = map[string]int32{
 	"UID":      7,
 	"PASSWORD": 8,
 	"STRING":   9,
+	"VFLOAT":   10,
 }
 
 func (x Posting_ValType) String() string {
@@ -314,6 +324,12 @@ type Query struct {
 	ExpandAll    bool         `protobuf:"varint,10,opt,name=expand_all,json=expandAll,proto3" json:"expand_all,omitempty"`
 	ReadTs       uint64       `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
 	LinRead      *api.LinRead `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	First        uint32       `protobuf:"varint,15,opt,name=first,proto3" json:"first,omitempty"`
+	Deadline     int64        `protobuf:"varint,16,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	MemoryBudget uint64       `protobuf:"varint,17,opt,name=memory_budget,json=memoryBudget,proto3" json:"memory_budget,omitempty"`
+	Random       uint32       `protobuf:"varint,18,opt,name=random,proto3" json:"random,omitempty"`
+	Seed         int64        `protobuf:"varint,19,opt,name=seed,proto3" json:"seed,omitempty"`
+	FuncVals     bool         `protobuf:"varint,20,opt,name=func_vals,json=funcVals,proto3" json:"func_vals,omitempty"`
 }
 
 func (m *Query) Reset()                    { *m = Query{} }
@@ -405,6 +421,48 @@ func (m *Query) GetLinRead() *api.LinRead {
 	return nil
 }
 
+func (m *Query) GetFirst() uint32 {
+	if m != nil {
+		return m.First
+	}
+	return 0
+}
+
+func (m *Query) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *Query) GetMemoryBudget() uint64 {
+	if m != nil {
+		return m.MemoryBudget
+	}
+	return 0
+}
+
+func (m *Query) GetRandom() uint32 {
+	if m != nil {
+		return m.Random
+	}
+	return 0
+}
+
+func (m *Query) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
+func (m *Query) GetFuncVals() bool {
+	if m != nil {
+		return m.FuncVals
+	}
+	return false
+}
+
 type ValueList struct {
 	Values []*TaskValue `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
 }
@@ -446,6 +504,8 @@ type Result struct {
 	LangMatrix    []*LangList   `protobuf:"bytes,6,rep,name=lang_matrix,json=langMatrix" json:"lang_matrix,omitempty"`
 	List          bool          `protobuf:"varint,7,opt,name=list,proto3" json:"list,omitempty"`
 	LinRead       *api.LinRead  `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	IndexKeys     uint64        `protobuf:"varint,8,opt,name=index_keys,json=indexKeys,proto3" json:"index_keys,omitempty"`
+	GroupId       uint32        `protobuf:"varint,9,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
 func (m *Result) Reset()                    { *m = Result{} }
@@ -509,10 +569,27 @@ func (m *Result) GetLinRead() *api.LinRead {
 	return nil
 }
 
+func (m *Result) GetIndexKeys() uint64 {
+	if m != nil {
+		return m.IndexKeys
+	}
+	return 0
+}
+
+func (m *Result) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
 type Order struct {
-	Attr  string   `protobuf:"bytes,1,opt,name=attr,proto3" json:"attr,omitempty"`
-	Desc  bool     `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
-	Langs []string `protobuf:"bytes,3,rep,name=langs" json:"langs,omitempty"`
+	Attr      string   `protobuf:"bytes,1,opt,name=attr,proto3" json:"attr,omitempty"`
+	Desc      bool     `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	Langs     []string `protobuf:"bytes,3,rep,name=langs" json:"langs,omitempty"`
+	Path      []string `protobuf:"bytes,4,rep,name=path" json:"path,omitempty"`
+	Aggregate string   `protobuf:"bytes,5,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	Nulls     string   `protobuf:"bytes,6,opt,name=nulls,proto3" json:"nulls,omitempty"`
 }
 
 func (m *Order) Reset()                    { *m = Order{} }
@@ -541,13 +618,37 @@ func (m *Order) GetLangs() []string {
 	return nil
 }
 
+func (m *Order) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *Order) GetAggregate() string {
+	if m != nil {
+		return m.Aggregate
+	}
+	return ""
+}
+
+func (m *Order) GetNulls() string {
+	if m != nil {
+		return m.Nulls
+	}
+	return ""
+}
+
 type SortMessage struct {
-	Order     []*Order     `protobuf:"bytes,1,rep,name=order" json:"order,omitempty"`
-	UidMatrix []*List      `protobuf:"bytes,2,rep,name=uid_matrix,json=uidMatrix" json:"uid_matrix,omitempty"`
-	Count     int32        `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Offset    int32        `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
-	ReadTs    uint64       `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
-	LinRead   *api.LinRead `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	Order        []*Order     `protobuf:"bytes,1,rep,name=order" json:"order,omitempty"`
+	UidMatrix    []*List      `protobuf:"bytes,2,rep,name=uid_matrix,json=uidMatrix" json:"uid_matrix,omitempty"`
+	Count        int32        `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Offset       int32        `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	ReadTs       uint64       `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
+	LinRead      *api.LinRead `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	After        *SortCursor  `protobuf:"bytes,5,opt,name=after" json:"after,omitempty"`
+	Deadline     int64        `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	MemoryBudget uint64       `protobuf:"varint,7,opt,name=memory_budget,json=memoryBudget,proto3" json:"memory_budget,omitempty"`
 }
 
 func (m *SortMessage) Reset()                    { *m = SortMessage{} }
@@ -597,9 +698,31 @@ func (m *SortMessage) GetLinRead() *api.LinRead {
 	return nil
 }
 
+func (m *SortMessage) GetAfter() *SortCursor {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *SortMessage) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *SortMessage) GetMemoryBudget() uint64 {
+	if m != nil {
+		return m.MemoryBudget
+	}
+	return 0
+}
+
 type SortResult struct {
 	UidMatrix []*List      `protobuf:"bytes,1,rep,name=uid_matrix,json=uidMatrix" json:"uid_matrix,omitempty"`
 	LinRead   *api.LinRead `protobuf:"bytes,14,opt,name=lin_read,json=linRead" json:"lin_read,omitempty"`
+	GroupId   uint32       `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
 func (m *SortResult) Reset()                    { *m = SortResult{} }
@@ -621,6 +744,13 @@ func (m *SortResult) GetLinRead() *api.LinRead {
 	return nil
 }
 
+func (m *SortResult) GetGroupId() uint32 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
 type RaftContext struct {
 	Id         uint64 `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
 	Group      uint32 `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`
@@ -760,6 +890,7 @@ type ZeroProposal struct {
 	MaxTxnTs   uint64          `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
 	MaxRaftId  uint64          `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
 	Txn        *api.TxnContext `protobuf:"bytes,7,opt,name=txn" json:"txn,omitempty"`
+	TimeMark   *TimeMark       `protobuf:"bytes,8,opt,name=time_mark,json=timeMark" json:"time_mark,omitempty"`
 }
 
 func (m *ZeroProposal) Reset()                    { *m = ZeroProposal{} }
@@ -816,6 +947,13 @@ func (m *ZeroProposal) GetTxn() *api.TxnContext {
 	return nil
 }
 
+func (m *ZeroProposal) GetTimeMark() *TimeMark {
+	if m != nil {
+		return m.TimeMark
+	}
+	return nil
+}
+
 // MembershipState is used to pack together the current membership state of all the nodes
 // in the caller server; and the membership updates recorded by the callee server since
 // the provided lastUpdate.
@@ -827,6 +965,7 @@ type MembershipState struct {
 	MaxTxnTs   uint64             `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
 	MaxRaftId  uint64             `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
 	Removed    []*Member          `protobuf:"bytes,7,rep,name=removed" json:"removed,omitempty"`
+	TimeMarks  []*TimeMark        `protobuf:"bytes,8,rep,name=time_marks,json=timeMarks" json:"time_marks,omitempty"`
 }
 
 func (m *MembershipState) Reset()                    { *m = MembershipState{} }
@@ -883,6 +1022,13 @@ func (m *MembershipState) GetRemoved() []*Member {
 	return nil
 }
 
+func (m *MembershipState) GetTimeMarks() []*TimeMark {
+	if m != nil {
+		return m.TimeMarks
+	}
+	return nil
+}
+
 type ConnectionState struct {
 	Member     *Member          `protobuf:"bytes,1,opt,name=member" json:"member,omitempty"`
 	State      *MembershipState `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
@@ -981,6 +1127,7 @@ type DirectedEdge struct {
 	Lang      string          `protobuf:"bytes,7,opt,name=lang,proto3" json:"lang,omitempty"`
 	Op        DirectedEdge_Op `protobuf:"varint,8,opt,name=op,proto3,enum=intern.DirectedEdge_Op" json:"op,omitempty"`
 	Facets    []*api.Facet    `protobuf:"bytes,9,rep,name=facets" json:"facets,omitempty"`
+	Positions []byte          `protobuf:"bytes,10,opt,name=positions,proto3" json:"positions,omitempty"`
 }
 
 func (m *DirectedEdge) Reset()                    { *m = DirectedEdge{} }
@@ -1051,6 +1198,13 @@ func (m *DirectedEdge) GetFacets() []*api.Facet {
 	return nil
 }
 
+func (m *DirectedEdge) GetPositions() []byte {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
 type Mutations struct {
 	GroupId             uint32          `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	StartTs             uint64          `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
@@ -1058,6 +1212,7 @@ type Mutations struct {
 	Schema              []*SchemaUpdate `protobuf:"bytes,4,rep,name=schema" json:"schema,omitempty"`
 	DropAll             bool            `protobuf:"varint,5,opt,name=drop_all,json=dropAll,proto3" json:"drop_all,omitempty"`
 	IgnoreIndexConflict bool            `protobuf:"varint,6,opt,name=ignore_index_conflict,json=ignoreIndexConflict,proto3" json:"ignore_index_conflict,omitempty"`
+	Types               []*TypeUpdate   `protobuf:"bytes,7,rep,name=types" json:"types,omitempty"`
 }
 
 func (m *Mutations) Reset()                    { *m = Mutations{} }
@@ -1107,6 +1262,13 @@ func (m *Mutations) GetIgnoreIndexConflict() bool {
 	return false
 }
 
+func (m *Mutations) GetTypes() []*TypeUpdate {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
 type KeyValues struct {
 	Kv []*KV `protobuf:"bytes,1,rep,name=kv" json:"kv,omitempty"`
 }
@@ -1253,9 +1415,10 @@ type Posting struct {
 	Label       string              `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
 	Facets      []*api.Facet        `protobuf:"bytes,9,rep,name=facets" json:"facets,omitempty"`
 	// TODO: op is only used temporarily. See if we can remove it from here.
-	Op       uint32 `protobuf:"varint,12,opt,name=op,proto3" json:"op,omitempty"`
-	StartTs  uint64 `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	CommitTs uint64 `protobuf:"varint,14,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	Op        uint32 `protobuf:"varint,12,opt,name=op,proto3" json:"op,omitempty"`
+	StartTs   uint64 `protobuf:"varint,13,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	CommitTs  uint64 `protobuf:"varint,14,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	Positions []byte `protobuf:"bytes,7,opt,name=positions,proto3" json:"positions,omitempty"`
 }
 
 func (m *Posting) Reset()                    { *m = Posting{} }
@@ -1333,6 +1496,13 @@ func (m *Posting) GetCommitTs() uint64 {
 	return 0
 }
 
+func (m *Posting) GetPositions() []byte {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
 type PostingList struct {
 	Postings []*Posting `protobuf:"bytes,1,rep,name=postings" json:"postings,omitempty"`
 	Checksum []byte     `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
@@ -1577,6 +1747,7 @@ type SchemaUpdate struct {
 	List      bool                   `protobuf:"varint,6,opt,name=list,proto3" json:"list,omitempty"`
 	Upsert    bool                   `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"`
 	Lang      bool                   `protobuf:"varint,9,opt,name=lang,proto3" json:"lang,omitempty"`
+	Analyzer  *AnalyzerConfig        `protobuf:"bytes,10,opt,name=analyzer" json:"analyzer,omitempty"`
 }
 
 func (m *SchemaUpdate) Reset()                    { *m = SchemaUpdate{} }
@@ -1640,6 +1811,13 @@ func (m *SchemaUpdate) GetLang() bool {
 	return false
 }
 
+func (m *SchemaUpdate) GetAnalyzer() *AnalyzerConfig {
+	if m != nil {
+		return m.Analyzer
+	}
+	return nil
+}
+
 // Bulk loader proto.
 type MapEntry struct {
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -1861,6 +2039,238 @@ func (m *SnapshotMeta) GetGroupId() uint32 {
 	return 0
 }
 
+type TypeUpdate struct {
+	TypeName string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Fields   []string `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *TypeUpdate) Reset()                    { *m = TypeUpdate{} }
+func (m *TypeUpdate) String() string            { return proto.CompactTextString(m) }
+func (*TypeUpdate) ProtoMessage()               {}
+func (*TypeUpdate) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{42} }
+
+func (m *TypeUpdate) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *TypeUpdate) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type AnalyzerConfig struct {
+	Lang            string   `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	Stopwords       []string `protobuf:"bytes,2,rep,name=stopwords" json:"stopwords,omitempty"`
+	CustomStopwords bool     `protobuf:"varint,3,opt,name=custom_stopwords,json=customStopwords,proto3" json:"custom_stopwords,omitempty"`
+	SynonymsFile    string   `protobuf:"bytes,4,opt,name=synonyms_file,json=synonymsFile,proto3" json:"synonyms_file,omitempty"`
+	Synonyms        []string `protobuf:"bytes,5,rep,name=synonyms" json:"synonyms,omitempty"`
+	NoStem          bool     `protobuf:"varint,6,opt,name=no_stem,json=noStem,proto3" json:"no_stem,omitempty"`
+}
+
+func (m *AnalyzerConfig) Reset()                    { *m = AnalyzerConfig{} }
+func (m *AnalyzerConfig) String() string            { return proto.CompactTextString(m) }
+func (*AnalyzerConfig) ProtoMessage()               {}
+func (*AnalyzerConfig) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{43} }
+
+func (m *AnalyzerConfig) GetLang() string {
+	if m != nil {
+		return m.Lang
+	}
+	return ""
+}
+
+func (m *AnalyzerConfig) GetStopwords() []string {
+	if m != nil {
+		return m.Stopwords
+	}
+	return nil
+}
+
+func (m *AnalyzerConfig) GetCustomStopwords() bool {
+	if m != nil {
+		return m.CustomStopwords
+	}
+	return false
+}
+
+func (m *AnalyzerConfig) GetSynonymsFile() string {
+	if m != nil {
+		return m.SynonymsFile
+	}
+	return ""
+}
+
+func (m *AnalyzerConfig) GetSynonyms() []string {
+	if m != nil {
+		return m.Synonyms
+	}
+	return nil
+}
+
+func (m *AnalyzerConfig) GetNoStem() bool {
+	if m != nil {
+		return m.NoStem
+	}
+	return false
+}
+
+type ChangeEvent struct {
+	Edge     *DirectedEdge `protobuf:"bytes,1,opt,name=edge" json:"edge,omitempty"`
+	StartTs  uint64        `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	CommitTs uint64        `protobuf:"varint,3,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	LogId    uint64        `protobuf:"varint,4,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	Seq      uint64        `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (m *ChangeEvent) Reset()                    { *m = ChangeEvent{} }
+func (m *ChangeEvent) String() string            { return proto.CompactTextString(m) }
+func (*ChangeEvent) ProtoMessage()               {}
+func (*ChangeEvent) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{44} }
+
+func (m *ChangeEvent) GetEdge() *DirectedEdge {
+	if m != nil {
+		return m.Edge
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetStartTs() uint64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *ChangeEvent) GetCommitTs() uint64 {
+	if m != nil {
+		return m.CommitTs
+	}
+	return 0
+}
+
+func (m *ChangeEvent) GetLogId() uint64 {
+	if m != nil {
+		return m.LogId
+	}
+	return 0
+}
+
+func (m *ChangeEvent) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type ChangesRequest struct {
+	SinceTs  uint64 `protobuf:"varint,1,opt,name=since_ts,json=sinceTs,proto3" json:"since_ts,omitempty"`
+	LogId    uint64 `protobuf:"varint,2,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	AfterSeq uint64 `protobuf:"varint,3,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`
+}
+
+func (m *ChangesRequest) Reset()                    { *m = ChangesRequest{} }
+func (m *ChangesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChangesRequest) ProtoMessage()               {}
+func (*ChangesRequest) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{45} }
+
+func (m *ChangesRequest) GetSinceTs() uint64 {
+	if m != nil {
+		return m.SinceTs
+	}
+	return 0
+}
+
+func (m *ChangesRequest) GetLogId() uint64 {
+	if m != nil {
+		return m.LogId
+	}
+	return 0
+}
+
+func (m *ChangesRequest) GetAfterSeq() uint64 {
+	if m != nil {
+		return m.AfterSeq
+	}
+	return 0
+}
+
+type Time struct {
+	UnixNano int64 `protobuf:"varint,1,opt,name=unix_nano,json=unixNano,proto3" json:"unix_nano,omitempty"`
+}
+
+func (m *Time) Reset()                    { *m = Time{} }
+func (m *Time) String() string            { return proto.CompactTextString(m) }
+func (*Time) ProtoMessage()               {}
+func (*Time) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{46} }
+
+func (m *Time) GetUnixNano() int64 {
+	if m != nil {
+		return m.UnixNano
+	}
+	return 0
+}
+
+type SortCursor struct {
+	Values []*TaskValue `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
+	Nulls  uint64       `protobuf:"varint,2,opt,name=nulls,proto3" json:"nulls,omitempty"`
+	Uid    uint64       `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (m *SortCursor) Reset()                    { *m = SortCursor{} }
+func (m *SortCursor) String() string            { return proto.CompactTextString(m) }
+func (*SortCursor) ProtoMessage()               {}
+func (*SortCursor) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{47} }
+
+func (m *SortCursor) GetValues() []*TaskValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *SortCursor) GetNulls() uint64 {
+	if m != nil {
+		return m.Nulls
+	}
+	return 0
+}
+
+func (m *SortCursor) GetUid() uint64 {
+	if m != nil {
+		return m.Uid
+	}
+	return 0
+}
+
+type TimeMark struct {
+	UnixNano int64  `protobuf:"varint,1,opt,name=unix_nano,json=unixNano,proto3" json:"unix_nano,omitempty"`
+	Ts       uint64 `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+}
+
+func (m *TimeMark) Reset()                    { *m = TimeMark{} }
+func (m *TimeMark) String() string            { return proto.CompactTextString(m) }
+func (*TimeMark) ProtoMessage()               {}
+func (*TimeMark) Descriptor() ([]byte, []int) { return fileDescriptorInternal, []int{48} }
+
+func (m *TimeMark) GetUnixNano() int64 {
+	if m != nil {
+		return m.UnixNano
+	}
+	return 0
+}
+
+func (m *TimeMark) GetTs() uint64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*List)(nil), "intern.List")
 	proto.RegisterType((*TaskValue)(nil), "intern.TaskValue")
@@ -1904,6 +2314,13 @@ func init() {
 	proto.RegisterType((*PeerResponse)(nil), "intern.PeerResponse")
 	proto.RegisterType((*Num)(nil), "intern.Num")
 	proto.RegisterType((*SnapshotMeta)(nil), "intern.SnapshotMeta")
+	proto.RegisterType((*TypeUpdate)(nil), "intern.TypeUpdate")
+	proto.RegisterType((*AnalyzerConfig)(nil), "intern.AnalyzerConfig")
+	proto.RegisterType((*ChangeEvent)(nil), "intern.ChangeEvent")
+	proto.RegisterType((*ChangesRequest)(nil), "intern.ChangesRequest")
+	proto.RegisterType((*Time)(nil), "intern.Time")
+	proto.RegisterType((*SortCursor)(nil), "intern.SortCursor")
+	proto.RegisterType((*TimeMark)(nil), "intern.TimeMark")
 	proto.RegisterEnum("intern.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
 	proto.RegisterEnum("intern.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
 	proto.RegisterEnum("intern.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
@@ -2093,6 +2510,7 @@ type ZeroClient interface {
 	Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*api.AssignedIds, error)
 	CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error)
 	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*TxnTimestamps, error)
+	TimestampAt(ctx context.Context, in *Time, opts ...grpc.CallOption) (*Num, error)
 }
 
 type zeroClient struct {
@@ -2220,6 +2638,15 @@ func (c *zeroClient) TryAbort(ctx context.Context, in *TxnTimestamps, opts ...gr
 	return out, nil
 }
 
+func (c *zeroClient) TimestampAt(ctx context.Context, in *Time, opts ...grpc.CallOption) (*Num, error) {
+	out := new(Num)
+	err := grpc.Invoke(ctx, "/intern.Zero/TimestampAt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Zero service
 
 type ZeroServer interface {
@@ -2231,6 +2658,7 @@ type ZeroServer interface {
 	Timestamps(context.Context, *Num) (*api.AssignedIds, error)
 	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
 	TryAbort(context.Context, *TxnTimestamps) (*TxnTimestamps, error)
+	TimestampAt(context.Context, *Time) (*Num, error)
 }
 
 func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
@@ -2392,6 +2820,24 @@ func _Zero_TryAbort_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zero_TimestampAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Time)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).TimestampAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/intern.Zero/TimestampAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).TimestampAt(ctx, req.(*Time))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Zero_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "intern.Zero",
 	HandlerType: (*ZeroServer)(nil),
@@ -2420,6 +2866,10 @@ var _Zero_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TryAbort",
 			Handler:    _Zero_TryAbort_Handler,
 		},
+		{
+			MethodName: "TimestampAt",
+			Handler:    _Zero_TimestampAt_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2829,6 +3279,157 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 	Metadata: "internal.proto",
 }
 
+// Client API for Subscriptions service
+
+type SubscriptionsClient interface {
+	Subscribe(ctx context.Context, in *api.Request, opts ...grpc.CallOption) (Subscriptions_SubscribeClient, error)
+	Changes(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (Subscriptions_ChangesClient, error)
+}
+
+type subscriptionsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSubscriptionsClient(cc *grpc.ClientConn) SubscriptionsClient {
+	return &subscriptionsClient{cc}
+}
+
+func (c *subscriptionsClient) Subscribe(ctx context.Context, in *api.Request, opts ...grpc.CallOption) (Subscriptions_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Subscriptions_serviceDesc.Streams[0], c.cc, "/intern.Subscriptions/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &subscriptionsSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Subscriptions_SubscribeClient interface {
+	Recv() (*api.Response, error)
+	grpc.ClientStream
+}
+
+type subscriptionsSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *subscriptionsSubscribeClient) Recv() (*api.Response, error) {
+	m := new(api.Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *subscriptionsClient) Changes(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (Subscriptions_ChangesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Subscriptions_serviceDesc.Streams[1], c.cc, "/intern.Subscriptions/Changes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &subscriptionsChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Subscriptions_ChangesClient interface {
+	Recv() (*ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type subscriptionsChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *subscriptionsChangesClient) Recv() (*ChangeEvent, error) {
+	m := new(ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Subscriptions service
+
+type SubscriptionsServer interface {
+	Subscribe(*api.Request, Subscriptions_SubscribeServer) error
+	Changes(*ChangesRequest, Subscriptions_ChangesServer) error
+}
+
+func RegisterSubscriptionsServer(s *grpc.Server, srv SubscriptionsServer) {
+	s.RegisterService(&_Subscriptions_serviceDesc, srv)
+}
+
+func _Subscriptions_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubscriptionsServer).Subscribe(m, &subscriptionsSubscribeServer{stream})
+}
+
+type Subscriptions_SubscribeServer interface {
+	Send(*api.Response) error
+	grpc.ServerStream
+}
+
+type subscriptionsSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *subscriptionsSubscribeServer) Send(m *api.Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Subscriptions_Changes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubscriptionsServer).Changes(m, &subscriptionsChangesServer{stream})
+}
+
+type Subscriptions_ChangesServer interface {
+	Send(*ChangeEvent) error
+	grpc.ServerStream
+}
+
+type subscriptionsChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *subscriptionsChangesServer) Send(m *ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Subscriptions_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "intern.Subscriptions",
+	HandlerType: (*SubscriptionsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Subscriptions_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Changes",
+			Handler:       _Subscriptions_Changes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal.proto",
+}
+
 func (m *List) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3074,6 +3675,51 @@ func (m *Query) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n5
 	}
+	if m.First != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.First))
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Deadline))
+	}
+	if m.MemoryBudget != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.MemoryBudget))
+	}
+	if m.Random != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Random))
+	}
+	if m.Seed != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Seed))
+	}
+	if m.FuncVals {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.FuncVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -3250,6 +3896,16 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n8
 	}
+	if m.IndexKeys != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.IndexKeys))
+	}
+	if m.GroupId != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.GroupId))
+	}
 	return i, nil
 }
 
@@ -3299,6 +3955,33 @@ func (m *Order) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.Path) > 0 {
+		for _, s := range m.Path {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Aggregate) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Aggregate)))
+		i += copy(dAtA[i:], m.Aggregate)
+	}
+	if len(m.Nulls) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Nulls)))
+		i += copy(dAtA[i:], m.Nulls)
+	}
 	return i, nil
 }
 
@@ -3366,6 +4049,26 @@ func (m *SortMessage) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n9
 	}
+	if m.After != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.After.Size()))
+		n28, err := m.After.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Deadline))
+	}
+	if m.MemoryBudget != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.MemoryBudget))
+	}
 	return i, nil
 }
 
@@ -3406,6 +4109,11 @@ func (m *SortResult) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n10
 	}
+	if m.GroupId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.GroupId))
+	}
 	return i, nil
 }
 
@@ -3655,6 +4363,16 @@ func (m *ZeroProposal) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n15
 	}
+	if m.TimeMark != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.TimeMark.Size()))
+		n29, err := m.TimeMark.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
 	return i, nil
 }
 
@@ -3759,6 +4477,18 @@ func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.TimeMarks) > 0 {
+		for _, msg := range m.TimeMarks {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -3940,6 +4670,12 @@ func (m *DirectedEdge) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Positions) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Positions)))
+		i += copy(dAtA[i:], m.Positions)
+	}
 	return i, nil
 }
 
@@ -4012,6 +4748,18 @@ func (m *Mutations) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Types) > 0 {
+		for _, msg := range m.Types {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -4268,6 +5016,12 @@ func (m *Posting) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintInternal(dAtA, i, uint64(m.CommitTs))
 	}
+	if len(m.Positions) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Positions)))
+		i += copy(dAtA[i:], m.Positions)
+	}
 	return i, nil
 }
 
@@ -4708,6 +5462,16 @@ func (m *SchemaUpdate) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Analyzer != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Analyzer.Size()))
+		n26, err := m.Analyzer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
 	return i, nil
 }
 
@@ -5001,176 +5765,485 @@ func (m *SnapshotMeta) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Internal(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Internal(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintInternal(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *List) Size() (n int) {
+
+func (m *TypeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Uids) > 0 {
-		n += 1 + sovInternal(uint64(len(m.Uids)*8)) + len(m.Uids)*8
+	if len(m.TypeName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.TypeName)))
+		i += copy(dAtA[i:], m.TypeName)
 	}
-	return n
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
 }
 
-func (m *TaskValue) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Val)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.ValType != 0 {
-		n += 1 + sovInternal(uint64(m.ValType))
+func (m *AnalyzerConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SrcFunction) Size() (n int) {
+func (m *AnalyzerConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.Lang) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Lang)))
+		i += copy(dAtA[i:], m.Lang)
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
+	if len(m.Stopwords) > 0 {
+		for _, s := range m.Stopwords {
+			dAtA[i] = 0x12
+			i++
 			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if m.IsCount {
-		n += 2
+	if m.CustomStopwords {
+		dAtA[i] = 0x18
+		i++
+		if m.CustomStopwords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	if len(m.SynonymsFile) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.SynonymsFile)))
+		i += copy(dAtA[i:], m.SynonymsFile)
+	}
+	if len(m.Synonyms) > 0 {
+		for _, s := range m.Synonyms {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.NoStem {
+		dAtA[i] = 0x30
+		i++
+		if m.NoStem {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *Query) Size() (n int) {
+func (m *ChangeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if len(m.Langs) > 0 {
-		for _, s := range m.Langs {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
+	if m.Edge != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Edge.Size()))
+		n27, err := m.Edge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n27
 	}
-	if m.AfterUid != 0 {
-		n += 9
-	}
-	if m.DoCount {
-		n += 2
+	if m.StartTs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.StartTs))
 	}
-	if m.UidList != nil {
-		l = m.UidList.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.CommitTs != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.CommitTs))
 	}
-	if m.SrcFunc != nil {
-		l = m.SrcFunc.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.LogId != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.LogId))
 	}
-	if m.Reverse {
-		n += 2
+	if m.Seq != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Seq))
 	}
-	if m.FacetParam != nil {
-		l = m.FacetParam.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return i, nil
+}
+
+func (m *ChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.FacetsFilter != nil {
-		l = m.FacetsFilter.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SinceTs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.SinceTs))
 	}
-	if m.ExpandAll {
-		n += 2
+	if m.LogId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.LogId))
 	}
-	if m.ReadTs != 0 {
-		n += 1 + sovInternal(uint64(m.ReadTs))
+	if m.AfterSeq != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.AfterSeq))
 	}
-	if m.LinRead != nil {
-		l = m.LinRead.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return i, nil
+}
+
+func (m *Time) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValueList) Size() (n int) {
+func (m *Time) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
+	if m.UnixNano != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.UnixNano))
 	}
-	return n
+	return i, nil
 }
 
-func (m *LangList) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Lang) > 0 {
-		for _, s := range m.Lang {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
-		}
+func (m *SortCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Result) Size() (n int) {
+func (m *SortCursor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if len(m.ValueMatrix) > 0 {
-		for _, e := range m.ValueMatrix {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
+	if m.Nulls != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Nulls))
 	}
-	if len(m.Counts) > 0 {
-		l = 0
-		for _, e := range m.Counts {
-			l += sovInternal(uint64(e))
-		}
-		n += 1 + sovInternal(uint64(l)) + l
+	if m.Uid != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Uid))
 	}
-	if m.IntersectDest {
-		n += 2
+	return i, nil
+}
+
+func (m *TimeMark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.FacetMatrix) > 0 {
-		for _, e := range m.FacetMatrix {
+	return dAtA[:n], nil
+}
+
+func (m *TimeMark) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.UnixNano != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.UnixNano))
+	}
+	if m.Ts != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInternal(dAtA, i, uint64(m.Ts))
+	}
+	return i, nil
+}
+
+func encodeFixed64Internal(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Internal(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintInternal(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *List) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Uids) > 0 {
+		n += 1 + sovInternal(uint64(len(m.Uids)*8)) + len(m.Uids)*8
+	}
+	return n
+}
+
+func (m *TaskValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Val)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ValType != 0 {
+		n += 1 + sovInternal(uint64(m.ValType))
+	}
+	return n
+}
+
+func (m *SrcFunction) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.IsCount {
+		n += 2
+	}
+	return n
+}
+
+func (m *Query) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Attr)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Langs) > 0 {
+		for _, s := range m.Langs {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.AfterUid != 0 {
+		n += 9
+	}
+	if m.DoCount {
+		n += 2
+	}
+	if m.UidList != nil {
+		l = m.UidList.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.SrcFunc != nil {
+		l = m.SrcFunc.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.FacetParam != nil {
+		l = m.FacetParam.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.FacetsFilter != nil {
+		l = m.FacetsFilter.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ExpandAll {
+		n += 2
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovInternal(uint64(m.ReadTs))
+	}
+	if m.LinRead != nil {
+		l = m.LinRead.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.First != 0 {
+		n += 1 + sovInternal(uint64(m.First))
+	}
+	if m.Deadline != 0 {
+		n += 2 + sovInternal(uint64(m.Deadline))
+	}
+	if m.MemoryBudget != 0 {
+		n += 2 + sovInternal(uint64(m.MemoryBudget))
+	}
+	if m.Random != 0 {
+		n += 2 + sovInternal(uint64(m.Random))
+	}
+	if m.Seed != 0 {
+		n += 2 + sovInternal(uint64(m.Seed))
+	}
+	if m.FuncVals {
+		n += 3
+	}
+	return n
+}
+
+func (m *ValueList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LangList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Lang) > 0 {
+		for _, s := range m.Lang {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Result) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.ValueMatrix) > 0 {
+		for _, e := range m.ValueMatrix {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovInternal(uint64(e))
+		}
+		n += 1 + sovInternal(uint64(l)) + l
+	}
+	if m.IntersectDest {
+		n += 2
+	}
+	if len(m.FacetMatrix) > 0 {
+		for _, e := range m.FacetMatrix {
 			l = e.Size()
 			n += 1 + l + sovInternal(uint64(l))
 		}
@@ -5188,6 +6261,12 @@ func (m *Result) Size() (n int) {
 		l = m.LinRead.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.IndexKeys != 0 {
+		n += 1 + sovInternal(uint64(m.IndexKeys))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
 	return n
 }
 
@@ -5207,6 +6286,20 @@ func (m *Order) Size() (n int) {
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
+	if len(m.Path) > 0 {
+		for _, s := range m.Path {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	l = len(m.Aggregate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Nulls)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	return n
 }
 
@@ -5238,6 +6331,16 @@ func (m *SortMessage) Size() (n int) {
 		l = m.LinRead.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.After != nil {
+		l = m.After.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovInternal(uint64(m.Deadline))
+	}
+	if m.MemoryBudget != 0 {
+		n += 1 + sovInternal(uint64(m.MemoryBudget))
+	}
 	return n
 }
 
@@ -5254,6 +6357,9 @@ func (m *SortResult) Size() (n int) {
 		l = m.LinRead.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.GroupId != 0 {
+		n += 1 + sovInternal(uint64(m.GroupId))
+	}
 	return n
 }
 
@@ -5363,6 +6469,10 @@ func (m *ZeroProposal) Size() (n int) {
 		l = m.Txn.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.TimeMark != nil {
+		l = m.TimeMark.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	return n
 }
 
@@ -5413,6 +6523,12 @@ func (m *MembershipState) Size() (n int) {
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
+	if len(m.TimeMarks) > 0 {
+		for _, e := range m.TimeMarks {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5495,6 +6611,10 @@ func (m *DirectedEdge) Size() (n int) {
 			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
+	l = len(m.Positions)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	return n
 }
 
@@ -5525,6 +6645,12 @@ func (m *Mutations) Size() (n int) {
 	if m.IgnoreIndexConflict {
 		n += 2
 	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5647,6 +6773,10 @@ func (m *Posting) Size() (n int) {
 	if m.CommitTs != 0 {
 		n += 1 + sovInternal(uint64(m.CommitTs))
 	}
+	l = len(m.Positions)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	return n
 }
 
@@ -5830,6 +6960,10 @@ func (m *SchemaUpdate) Size() (n int) {
 	if m.Lang {
 		n += 2
 	}
+	if m.Analyzer != nil {
+		l = m.Analyzer.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	return n
 }
 
@@ -5955,26 +7089,150 @@ func (m *SnapshotMeta) Size() (n int) {
 	return n
 }
 
-func sovInternal(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *TypeUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
 		}
 	}
 	return n
 }
-func sozInternal(x uint64) (n int) {
-	return sovInternal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *List) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *AnalyzerConfig) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Lang)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Stopwords) > 0 {
+		for _, s := range m.Stopwords {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.CustomStopwords {
+		n += 2
+	}
+	l = len(m.SynonymsFile)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Synonyms) > 0 {
+		for _, s := range m.Synonyms {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.NoStem {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChangeEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Edge != nil {
+		l = m.Edge.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovInternal(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovInternal(uint64(m.CommitTs))
+	}
+	if m.LogId != 0 {
+		n += 1 + sovInternal(uint64(m.LogId))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovInternal(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *ChangesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.SinceTs != 0 {
+		n += 1 + sovInternal(uint64(m.SinceTs))
+	}
+	if m.LogId != 0 {
+		n += 1 + sovInternal(uint64(m.LogId))
+	}
+	if m.AfterSeq != 0 {
+		n += 1 + sovInternal(uint64(m.AfterSeq))
+	}
+	return n
+}
+
+func (m *Time) Size() (n int) {
+	var l int
+	_ = l
+	if m.UnixNano != 0 {
+		n += 1 + sovInternal(uint64(m.UnixNano))
+	}
+	return n
+}
+
+func (m *SortCursor) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.Nulls != 0 {
+		n += 1 + sovInternal(uint64(m.Nulls))
+	}
+	if m.Uid != 0 {
+		n += 1 + sovInternal(uint64(m.Uid))
+	}
+	return n
+}
+
+func (m *TimeMark) Size() (n int) {
+	var l int
+	_ = l
+	if m.UnixNano != 0 {
+		n += 1 + sovInternal(uint64(m.UnixNano))
+	}
+	if m.Ts != 0 {
+		n += 1 + sovInternal(uint64(m.Ts))
+	}
+	return n
+}
+
+func sovInternal(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozInternal(x uint64) (n int) {
+	return sovInternal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowInternal
 			}
@@ -6009,51 +7267,1270 @@ func (m *List) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += 8
+					v = uint64(dAtA[iNdEx-8])
+					v |= uint64(dAtA[iNdEx-7]) << 8
+					v |= uint64(dAtA[iNdEx-6]) << 16
+					v |= uint64(dAtA[iNdEx-5]) << 24
+					v |= uint64(dAtA[iNdEx-4]) << 32
+					v |= uint64(dAtA[iNdEx-3]) << 40
+					v |= uint64(dAtA[iNdEx-2]) << 48
+					v |= uint64(dAtA[iNdEx-1]) << 56
+					m.Uids = append(m.Uids, v)
+				}
+			} else if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 8
+				v = uint64(dAtA[iNdEx-8])
+				v |= uint64(dAtA[iNdEx-7]) << 8
+				v |= uint64(dAtA[iNdEx-6]) << 16
+				v |= uint64(dAtA[iNdEx-5]) << 24
+				v |= uint64(dAtA[iNdEx-4]) << 32
+				v |= uint64(dAtA[iNdEx-3]) << 40
+				v |= uint64(dAtA[iNdEx-2]) << 48
+				v |= uint64(dAtA[iNdEx-1]) << 56
+				m.Uids = append(m.Uids, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
+			if m.Val == nil {
+				m.Val = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			}
+			m.ValType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCount = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
+			}
+			m.AfterUid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.AfterUid = uint64(dAtA[iNdEx-8])
+			m.AfterUid |= uint64(dAtA[iNdEx-7]) << 8
+			m.AfterUid |= uint64(dAtA[iNdEx-6]) << 16
+			m.AfterUid |= uint64(dAtA[iNdEx-5]) << 24
+			m.AfterUid |= uint64(dAtA[iNdEx-4]) << 32
+			m.AfterUid |= uint64(dAtA[iNdEx-3]) << 40
+			m.AfterUid |= uint64(dAtA[iNdEx-2]) << 48
+			m.AfterUid |= uint64(dAtA[iNdEx-1]) << 56
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoCount = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UidList == nil {
+				m.UidList = &List{}
+			}
+			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SrcFunc == nil {
+				m.SrcFunc = &SrcFunction{}
+			}
+			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetParam == nil {
+				m.FacetParam = &FacetParams{}
+			}
+			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetsFilter == nil {
+				m.FacetsFilter = &FilterTree{}
+			}
+			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpandAll = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinRead == nil {
+				m.LinRead = &api.LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			m.First = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.First |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryBudget", wireType)
+			}
+			m.MemoryBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryBudget |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Random", wireType)
+			}
+			m.Random = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Random |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			m.Seed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuncVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FuncVals = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &TaskValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LangList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
+			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
+				m.Counts = append(m.Counts, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += 8
-					v = uint64(dAtA[iNdEx-8])
-					v |= uint64(dAtA[iNdEx-7]) << 8
-					v |= uint64(dAtA[iNdEx-6]) << 16
-					v |= uint64(dAtA[iNdEx-5]) << 24
-					v |= uint64(dAtA[iNdEx-4]) << 32
-					v |= uint64(dAtA[iNdEx-3]) << 40
-					v |= uint64(dAtA[iNdEx-2]) << 48
-					v |= uint64(dAtA[iNdEx-1]) << 56
-					m.Uids = append(m.Uids, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
+			}
+			m.IntersectDest = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				iNdEx += 8
-				v = uint64(dAtA[iNdEx-8])
-				v |= uint64(dAtA[iNdEx-7]) << 8
-				v |= uint64(dAtA[iNdEx-6]) << 16
-				v |= uint64(dAtA[iNdEx-5]) << 24
-				v |= uint64(dAtA[iNdEx-4]) << 32
-				v |= uint64(dAtA[iNdEx-3]) << 40
-				v |= uint64(dAtA[iNdEx-2]) << 48
-				v |= uint64(dAtA[iNdEx-1]) << 56
-				m.Uids = append(m.Uids, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
+			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LangMatrix = append(m.LangMatrix, &LangList{})
+			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.List = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinRead == nil {
+				m.LinRead = &api.LinRead{}
+			}
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexKeys", wireType)
+			}
+			m.IndexKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexKeys |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
 		default:
 			iNdEx = preIndex
@@ -6076,7 +8553,7 @@ func (m *List) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskValue) Unmarshal(dAtA []byte) error {
+func (m *Order) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6099,17 +8576,17 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6119,28 +8596,26 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
-			if m.Val == nil {
-				m.Val = []byte{}
-			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
 			}
-			m.ValType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6150,64 +8625,44 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Desc = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SrcFunction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6232,11 +8687,11 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Path = append(m.Path, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6261,13 +8716,13 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Aggregate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nulls", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6277,12 +8732,21 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsCount = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nulls = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -6304,7 +8768,7 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *SortMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6327,17 +8791,17 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6347,26 +8811,28 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
+			m.Order = append(m.Order, &Order{})
+			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6376,43 +8842,47 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.AfterUid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 8
-			m.AfterUid = uint64(dAtA[iNdEx-8])
-			m.AfterUid |= uint64(dAtA[iNdEx-7]) << 8
-			m.AfterUid |= uint64(dAtA[iNdEx-6]) << 16
-			m.AfterUid |= uint64(dAtA[iNdEx-5]) << 24
-			m.AfterUid |= uint64(dAtA[iNdEx-4]) << 32
-			m.AfterUid |= uint64(dAtA[iNdEx-3]) << 40
-			m.AfterUid |= uint64(dAtA[iNdEx-2]) << 48
-			m.AfterUid |= uint64(dAtA[iNdEx-1]) << 56
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var v int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6422,15 +8892,33 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Offset |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DoCount = bool(v != 0)
-		case 5:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6454,16 +8942,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UidList == nil {
-				m.UidList = &List{}
+			if m.LinRead == nil {
+				m.LinRead = &api.LinRead{}
 			}
-			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6487,18 +8975,37 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SrcFunc == nil {
-				m.SrcFunc = &SrcFunction{}
+			if m.After == nil {
+				m.After = &SortCursor{}
 			}
-			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryBudget", wireType)
 			}
-			var v int
+			m.MemoryBudget = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6508,15 +9015,64 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MemoryBudget |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reverse = bool(v != 0)
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6540,16 +9096,14 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FacetParam == nil {
-				m.FacetParam = &FacetParams{}
-			}
-			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6573,57 +9127,18 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FacetsFilter == nil {
-				m.FacetsFilter = &FilterTree{}
+			if m.LinRead == nil {
+				m.LinRead = &api.LinRead{}
 			}
-			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExpandAll = bool(v != 0)
-		case 13:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
-			}
-			m.ReadTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6633,25 +9148,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LinRead == nil {
-				m.LinRead = &api.LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -6673,7 +9174,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueList) Unmarshal(dAtA []byte) error {
+func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6696,17 +9197,53 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Id = uint64(dAtA[iNdEx-8])
+			m.Id |= uint64(dAtA[iNdEx-7]) << 8
+			m.Id |= uint64(dAtA[iNdEx-6]) << 16
+			m.Id |= uint64(dAtA[iNdEx-5]) << 24
+			m.Id |= uint64(dAtA[iNdEx-4]) << 32
+			m.Id |= uint64(dAtA[iNdEx-3]) << 40
+			m.Id |= uint64(dAtA[iNdEx-2]) << 48
+			m.Id |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6716,23 +9253,40 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, &TaskValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+			}
+			m.SnapshotTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -6754,7 +9308,7 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LangList) Unmarshal(dAtA []byte) error {
+func (m *Member) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6777,17 +9331,122 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Id = uint64(dAtA[iNdEx-8])
+			m.Id |= uint64(dAtA[iNdEx-7]) << 8
+			m.Id |= uint64(dAtA[iNdEx-6]) << 16
+			m.Id |= uint64(dAtA[iNdEx-5]) << 24
+			m.Id |= uint64(dAtA[iNdEx-4]) << 32
+			m.Id |= uint64(dAtA[iNdEx-3]) << 40
+			m.Id |= uint64(dAtA[iNdEx-2]) << 48
+			m.Id |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leader = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			m.AmDead = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			m.LastUpdate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6797,21 +9456,31 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.LastUpdate |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.ClusterInfoOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -6833,7 +9502,7 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *Group) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6856,15 +9525,15 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6888,14 +9557,94 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Members == nil {
+				m.Members = make(map[uint64]*Member)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Member{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Members[mapkey] = mapvalue
+			} else {
+				var mapvalue *Member
+				m.Members[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6919,14 +9668,51 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
-			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType == 2 {
-				var packedLen int
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Tablets == nil {
+				m.Tablets = make(map[string]*Tablet)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowInternal
@@ -6936,38 +9722,12 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					valuekey |= (uint64(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInternal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Counts = append(m.Counts, v)
-				}
-			} else if wireType == 0 {
-				var v uint32
+				var mapmsglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowInternal
@@ -6977,20 +9737,87 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= (uint32(b) & 0x7F) << shift
+					mapmsglen |= (int(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.Counts = append(m.Counts, v)
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Tablet{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Tablets[mapkey] = mapvalue
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+				var mapvalue *Tablet
+				m.Tablets[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7000,15 +9827,14 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Id |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IntersectDest = bool(v != 0)
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7032,14 +9858,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
-			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7063,16 +9891,18 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LangMatrix = append(m.LangMatrix, &LangList{})
-			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tablet == nil {
+				m.Tablet = &Tablet{}
+			}
+			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
 			}
-			var v int
+			m.MaxLeaseId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7082,17 +9912,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.List = bool(v != 0)
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
 			}
-			var msglen int
+			m.MaxTxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7102,80 +9931,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LinRead == nil {
-				m.LinRead = &api.LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Order) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
 			}
-			var stringLen uint64
+			m.MaxRaftId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7185,26 +9950,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7214,17 +9969,30 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Desc = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Txn == nil {
+				m.Txn = &api.TxnContext{}
+			}
+			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeMark", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7234,20 +10002,24 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			if m.TimeMark == nil {
+				m.TimeMark = &TimeMark{}
+			}
+			if err := m.TimeMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7270,7 +10042,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SortMessage) Unmarshal(dAtA []byte) error {
+func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7293,17 +10065,58 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			m.Counter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Counter |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7313,26 +10126,84 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+			var mapkey uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Groups == nil {
+				m.Groups = make(map[uint32]*Group)
 			}
-			m.Order = append(m.Order, &Order{})
-			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Group{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Groups[mapkey] = mapvalue
+			} else {
+				var mapvalue *Group
+				m.Groups[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7356,16 +10227,22 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Count = 0
+			var mapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7375,16 +10252,71 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (int32(b) & 0x7F) << shift
+				mapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if m.Zeros == nil {
+				m.Zeros = make(map[uint64]*Member)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Member{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Zeros[mapkey] = mapvalue
+			} else {
+				var mapvalue *Member
+				m.Zeros[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
 			}
-			m.Offset = 0
+			m.MaxLeaseId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7394,16 +10326,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (int32(b) & 0x7F) << shift
+				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
 			}
-			m.ReadTs = 0
+			m.MaxTxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7413,16 +10345,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
 			}
-			var msglen int
+			m.MaxRaftId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7432,78 +10364,14 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LinRead == nil {
-				m.LinRead = &api.LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SortResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7527,14 +10395,14 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Removed = append(m.Removed, &Member{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeMarks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7558,10 +10426,8 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinRead == nil {
-				m.LinRead = &api.LinRead{}
-			}
-			if err := m.LinRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TimeMarks = append(m.TimeMarks, &TimeMark{})
+			if err := m.TimeMarks[len(m.TimeMarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7586,7 +10452,7 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RaftContext) Unmarshal(dAtA []byte) error {
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7609,34 +10475,17 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Id = uint64(dAtA[iNdEx-8])
-			m.Id |= uint64(dAtA[iNdEx-7]) << 8
-			m.Id |= uint64(dAtA[iNdEx-6]) << 16
-			m.Id |= uint64(dAtA[iNdEx-5]) << 24
-			m.Id |= uint64(dAtA[iNdEx-4]) << 32
-			m.Id |= uint64(dAtA[iNdEx-3]) << 40
-			m.Id |= uint64(dAtA[iNdEx-2]) << 48
-			m.Id |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			m.Group = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7646,16 +10495,30 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7665,26 +10528,30 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
 			}
-			m.SnapshotTs = 0
+			m.MaxPending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7694,7 +10561,7 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTs |= (uint64(b) & 0x7F) << shift
+				m.MaxPending |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7720,7 +10587,7 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *Tablet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7743,30 +10610,13 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Id = uint64(dAtA[iNdEx-8])
-			m.Id |= uint64(dAtA[iNdEx-7]) << 8
-			m.Id |= uint64(dAtA[iNdEx-6]) << 16
-			m.Id |= uint64(dAtA[iNdEx-5]) << 24
-			m.Id |= uint64(dAtA[iNdEx-4]) << 32
-			m.Id |= uint64(dAtA[iNdEx-3]) << 40
-			m.Id |= uint64(dAtA[iNdEx-2]) << 48
-			m.Id |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
@@ -7785,9 +10635,9 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7812,11 +10662,11 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -7833,10 +10683,10 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Leader = bool(v != 0)
-		case 5:
+			m.Force = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -7853,12 +10703,12 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AmDead = bool(v != 0)
-		case 6:
+			m.ReadOnly = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
 			}
-			m.LastUpdate = 0
+			m.Space = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7868,14 +10718,14 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdate |= (uint64(b) & 0x7F) << shift
+				m.Space |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -7892,7 +10742,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ClusterInfoOnly = bool(v != 0)
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -7914,7 +10764,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Group) Unmarshal(dAtA []byte) error {
+func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7937,17 +10787,130 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
+			}
+			m.Entity = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Entity = uint64(dAtA[iNdEx-8])
+			m.Entity |= uint64(dAtA[iNdEx-7]) << 8
+			m.Entity |= uint64(dAtA[iNdEx-6]) << 16
+			m.Entity |= uint64(dAtA[iNdEx-5]) << 24
+			m.Entity |= uint64(dAtA[iNdEx-4]) << 32
+			m.Entity |= uint64(dAtA[iNdEx-3]) << 40
+			m.Entity |= uint64(dAtA[iNdEx-2]) << 48
+			m.Entity |= uint64(dAtA[iNdEx-1]) << 56
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
+			}
+			m.ValueId = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.ValueId = uint64(dAtA[iNdEx-8])
+			m.ValueId |= uint64(dAtA[iNdEx-7]) << 8
+			m.ValueId |= uint64(dAtA[iNdEx-6]) << 16
+			m.ValueId |= uint64(dAtA[iNdEx-5]) << 24
+			m.ValueId |= uint64(dAtA[iNdEx-4]) << 32
+			m.ValueId |= uint64(dAtA[iNdEx-3]) << 40
+			m.ValueId |= uint64(dAtA[iNdEx-2]) << 48
+			m.ValueId |= uint64(dAtA[iNdEx-1]) << 56
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7957,19 +10920,26 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7979,12 +10949,26 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var mapkey uint64
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7994,69 +10978,14 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint64(b) & 0x7F) << shift
+				m.Op |= (DirectedEdge_Op(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if m.Members == nil {
-				m.Members = make(map[uint64]*Member)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Member{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Members[mapkey] = mapvalue
-			} else {
-				var mapvalue *Member
-				m.Members[mapkey] = mapvalue
-			}
-			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8080,22 +11009,16 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLenmapkey uint64
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8105,74 +11028,21 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Tablets == nil {
-				m.Tablets = make(map[string]*Tablet)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Tablet{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Tablets[mapkey] = mapvalue
-			} else {
-				var mapvalue *Tablet
-				m.Tablets[mapkey] = mapvalue
+			m.Positions = append(m.Positions[:0], dAtA[iNdEx:postIndex]...)
+			if m.Positions == nil {
+				m.Positions = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8196,7 +11066,7 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
+func (m *Mutations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8219,17 +11089,17 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.Id = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8239,16 +11109,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var msglen int
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8258,28 +11128,14 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StartTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8303,18 +11159,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tablet == nil {
-				m.Tablet = &Tablet{}
-			}
-			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Edges = append(m.Edges, &DirectedEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.MaxLeaseId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8324,16 +11178,28 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = append(m.Schema, &SchemaUpdate{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
 			}
-			m.MaxTxnTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8343,16 +11209,17 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DropAll = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreIndexConflict", wireType)
 			}
-			m.MaxRaftId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8362,14 +11229,15 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IgnoreIndexConflict = bool(v != 0)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8393,10 +11261,8 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Txn == nil {
-				m.Txn = &api.TxnContext{}
-			}
-			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Types = append(m.Types, &TypeUpdate{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8421,7 +11287,7 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MembershipState) Unmarshal(dAtA []byte) error {
+func (m *KeyValues) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8444,34 +11310,15 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyValues: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyValues: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
-			}
-			m.Counter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Counter |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8495,22 +11342,66 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Kv = append(m.Kv, &KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey uint32
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedId", wireType)
+			}
+			m.DeprecatedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8520,69 +11411,14 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint32(b) & 0x7F) << shift
+				m.DeprecatedId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if m.Groups == nil {
-				m.Groups = make(map[uint32]*Group)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Group{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Groups[mapkey] = mapvalue
-			} else {
-				var mapvalue *Group
-				m.Groups[mapkey] = mapvalue
-			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8606,22 +11442,18 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Mutations == nil {
+				m.Mutations = &Mutations{}
 			}
-			var mapkey uint64
+			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnContext", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8631,71 +11463,30 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				mapkey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if m.Zeros == nil {
-				m.Zeros = make(map[uint64]*Member)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Member{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postmsgIndex
-				m.Zeros[mapkey] = mapvalue
-			} else {
-				var mapvalue *Member
-				m.Zeros[mapkey] = mapvalue
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxnContext == nil {
+				m.TxnContext = &api.TxnContext{}
+			}
+			if err := m.TxnContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeaseId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
-			m.MaxLeaseId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8705,16 +11496,28 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxLeaseId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kv = append(m.Kv, &KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.MaxTxnTs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8724,16 +11527,30 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTxnTs |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
 			}
-			m.MaxRaftId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8743,16 +11560,26 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8762,22 +11589,20 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Removed = append(m.Removed, &Member{})
-			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8800,7 +11625,7 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *KVS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8823,48 +11648,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: KVS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KVS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8888,32 +11680,11 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Kv = append(m.Kv, &KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
-			}
-			m.MaxPending = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxPending |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -8935,7 +11706,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tablet) Unmarshal(dAtA []byte) error {
+func (m *KV) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8958,17 +11729,17 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
+			return fmt.Errorf("proto: KV: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KV: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.GroupId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8978,16 +11749,28 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8997,26 +11780,28 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
+			if m.Val == nil {
+				m.Val = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserMeta", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9026,56 +11811,28 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			m.ReadOnly = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Space = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Space |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.UserMeta = append(m.UserMeta[:0], dAtA[iNdEx:postIndex]...)
+			if m.UserMeta == nil {
+				m.UserMeta = []byte{}
 			}
-		case 8:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9085,12 +11842,11 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -9112,7 +11868,7 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
+func (m *Posting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9135,59 +11891,30 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
+			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			m.Entity = 0
+			m.Uid = 0
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += 8
-			m.Entity = uint64(dAtA[iNdEx-8])
-			m.Entity |= uint64(dAtA[iNdEx-7]) << 8
-			m.Entity |= uint64(dAtA[iNdEx-6]) << 16
-			m.Entity |= uint64(dAtA[iNdEx-5]) << 24
-			m.Entity |= uint64(dAtA[iNdEx-4]) << 32
-			m.Entity |= uint64(dAtA[iNdEx-3]) << 40
-			m.Entity |= uint64(dAtA[iNdEx-2]) << 48
-			m.Entity |= uint64(dAtA[iNdEx-1]) << 56
+			m.Uid = uint64(dAtA[iNdEx-8])
+			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
+			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
+			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
+			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
+			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
+			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
+			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
@@ -9218,11 +11945,11 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
 			}
-			m.ValueType = 0
+			m.ValType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9232,33 +11959,16 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
+				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
-			}
-			m.ValueId = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.ValueId = uint64(dAtA[iNdEx-8])
-			m.ValueId |= uint64(dAtA[iNdEx-7]) << 8
-			m.ValueId |= uint64(dAtA[iNdEx-6]) << 16
-			m.ValueId |= uint64(dAtA[iNdEx-5]) << 24
-			m.ValueId |= uint64(dAtA[iNdEx-4]) << 32
-			m.ValueId |= uint64(dAtA[iNdEx-3]) << 40
-			m.ValueId |= uint64(dAtA[iNdEx-2]) << 48
-			m.ValueId |= uint64(dAtA[iNdEx-1]) << 56
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
 			}
-			var stringLen uint64
+			m.PostingType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9268,26 +11978,16 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.PostingType |= (Posting_PostingType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Label = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9297,45 +11997,28 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lang = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= (DirectedEdge_Op(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
+			if m.LangTag == nil {
+				m.LangTag = []byte{}
 			}
-		case 9:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9345,114 +12028,24 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Mutations) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
-			}
-			m.StartTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9476,16 +12069,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Edges = append(m.Edges, &DirectedEdge{})
-			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var msglen int
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9495,28 +12088,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Op |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = append(m.Schema, &SchemaUpdate{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropAll", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var v int
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9526,17 +12107,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.StartTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DropAll = bool(v != 0)
-		case 6:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreIndexConflict", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var v int
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9546,67 +12126,16 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IgnoreIndexConflict = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KeyValues) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyValues: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyValues: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9616,21 +12145,21 @@ func (m *KeyValues) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Positions = append(m.Positions[:0], dAtA[iNdEx:postIndex]...)
+			if m.Positions == nil {
+				m.Positions = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9654,7 +12183,7 @@ func (m *KeyValues) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *PostingList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9677,34 +12206,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedId", wireType)
-			}
-			m.DeprecatedId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DeprecatedId |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9728,18 +12238,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mutations == nil {
-				m.Mutations = &Mutations{}
-			}
-			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Postings = append(m.Postings, &Posting{})
+			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxnContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9749,30 +12257,28 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxnContext == nil {
-				m.TxnContext = &api.TxnContext{}
-			}
-			if err := m.TxnContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			m.Commit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9782,28 +12288,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Commit |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Kv = append(m.Kv, &KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9813,28 +12307,76 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
+			m.Uids = append(m.Uids[:0], dAtA[iNdEx:postIndex]...)
+			if m.Uids == nil {
+				m.Uids = []byte{}
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9859,11 +12401,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9888,7 +12430,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Alias = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9911,7 +12453,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KVS) Unmarshal(dAtA []byte) error {
+func (m *FacetParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9934,15 +12476,35 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KVS: wiretype end group for non-group")
+			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KVS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllKeys = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9966,8 +12528,8 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Param = append(m.Param, &FacetParam{})
+			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9992,7 +12554,7 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KV) Unmarshal(dAtA []byte) error {
+func (m *Facets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10015,79 +12577,17 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KV: wiretype end group for non-group")
+			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KV: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
-			if m.Val == nil {
-				m.Val = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10097,42 +12597,23 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserMeta = append(m.UserMeta[:0], dAtA[iNdEx:postIndex]...)
-			if m.UserMeta == nil {
-				m.UserMeta = []byte{}
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -10154,7 +12635,7 @@ func (m *KV) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Posting) Unmarshal(dAtA []byte) error {
+func (m *FacetsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10177,34 +12658,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
+			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Uid = uint64(dAtA[iNdEx-8])
-			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
-			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
-			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
-			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
-			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
-			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
-			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10214,66 +12678,78 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.FacetsList = append(m.FacetsList, &Facets{})
+			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ValType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValType |= (Posting_ValType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PostingType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PostingType |= (Posting_PostingType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Function) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10283,26 +12759,24 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
-			if m.LangTag == nil {
-				m.LangTag = []byte{}
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10327,13 +12801,13 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10343,28 +12817,76 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FilterTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10374,16 +12896,26 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			m.StartTs = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10393,16 +12925,28 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			m.CommitTs = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &FilterTree{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10412,11 +12956,25 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Func == nil {
+				m.Func = &Function{}
+			}
+			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -10438,7 +12996,7 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostingList) Unmarshal(dAtA []byte) error {
+func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10461,17 +13019,36 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10481,28 +13058,26 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Postings = append(m.Postings, &Posting{})
-			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10512,47 +13087,76 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
-			if m.Checksum == nil {
-				m.Checksum = []byte{}
-			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchemaResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-			m.Commit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Commit |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10562,21 +13166,21 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uids = append(m.Uids[:0], dAtA[iNdEx:postIndex]...)
-			if m.Uids == nil {
-				m.Uids = []byte{}
+			m.Schema = append(m.Schema, &api.SchemaNode{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -10600,7 +13204,7 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FacetParam) Unmarshal(dAtA []byte) error {
+func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10623,15 +13227,15 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10656,11 +13260,49 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			m.ValueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
+			}
+			m.Directive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Directive |= (SchemaUpdate_Directive(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10685,61 +13327,71 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthInternal
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Count = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FacetParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.List = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Upsert = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10756,10 +13408,10 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AllKeys = bool(v != 0)
-		case 2:
+			m.Lang = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyzer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10783,8 +13435,10 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Param = append(m.Param, &FacetParam{})
-			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Analyzer == nil {
+				m.Analyzer = &AnalyzerConfig{}
+			}
+			if err := m.Analyzer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10809,7 +13463,7 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Facets) Unmarshal(dAtA []byte) error {
+func (m *MapEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10832,15 +13486,63 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			m.Uid = uint64(dAtA[iNdEx-8])
+			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
+			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
+			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
+			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
+			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
+			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
+			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10864,8 +13566,10 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Posting == nil {
+				m.Posting = &Posting{}
+			}
+			if err := m.Posting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10890,7 +13594,7 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FacetsList) Unmarshal(dAtA []byte) error {
+func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10913,15 +13617,82 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGroupId", wireType)
+			}
+			m.SourceGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceGroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestGroupId", wireType)
+			}
+			m.DestGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestGroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10945,8 +13716,10 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FacetsList = append(m.FacetsList, &Facets{})
-			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10971,7 +13744,7 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Function) Unmarshal(dAtA []byte) error {
+func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10994,17 +13767,17 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
 			}
-			var stringLen uint64
+			m.ReqId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11014,26 +13787,16 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ReqId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var stringLen uint64
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11043,26 +13806,16 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.GroupId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11072,21 +13825,30 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Status |= (ExportPayload_Status(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11108,7 +13870,7 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilterTree) Unmarshal(dAtA []byte) error {
+func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11131,17 +13893,17 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11151,57 +13913,155 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Op = string(dAtA[iNdEx:postIndex])
+			var mapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.Commits == nil {
+				m.Commits = make(map[uint64]uint64)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Commits[mapkey] = mapvalue
+			} else {
+				var mapvalue uint64
+				m.Commits[mapkey] = mapvalue
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Aborts = append(m.Aborts, v)
 				}
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Aborts = append(m.Aborts, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aborts", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Children = append(m.Children, &FilterTree{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
 			}
-			var msglen int
+			m.MaxPending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11211,25 +14071,11 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MaxPending |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Func == nil {
-				m.Func = &Function{}
-			}
-			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11251,7 +14097,7 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11274,89 +14120,74 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+			if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ts = append(m.Ts, v)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+			} else if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
+				m.Ts = append(m.Ts, v)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11378,7 +14209,7 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaResult) Unmarshal(dAtA []byte) error {
+func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11401,17 +14232,17 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11421,23 +14252,12 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = append(m.Schema, &api.SchemaNode{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Status = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11459,7 +14279,7 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
+func (m *Num) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11482,173 +14302,17 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Num: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
-			}
-			m.ValueType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValueType |= (Posting_ValType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
-			}
-			m.Directive = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Directive |= (SchemaUpdate_Directive(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Count = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.List = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Upsert = bool(v != 0)
-		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
-			var v int
+			m.Val = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11658,12 +14322,11 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Val |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lang = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11685,7 +14348,7 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapEntry) Unmarshal(dAtA []byte) error {
+func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11708,17 +14371,17 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotMeta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTs", wireType)
 			}
-			var byteLen int
+			m.ClientTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11728,45 +14391,16 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.ClientTs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			m.Uid = uint64(dAtA[iNdEx-8])
-			m.Uid |= uint64(dAtA[iNdEx-7]) << 8
-			m.Uid |= uint64(dAtA[iNdEx-6]) << 16
-			m.Uid |= uint64(dAtA[iNdEx-5]) << 24
-			m.Uid |= uint64(dAtA[iNdEx-4]) << 32
-			m.Uid |= uint64(dAtA[iNdEx-3]) << 40
-			m.Uid |= uint64(dAtA[iNdEx-2]) << 48
-			m.Uid |= uint64(dAtA[iNdEx-1]) << 56
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Posting", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11776,25 +14410,11 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Posting == nil {
-				m.Posting = &Posting{}
-			}
-			if err := m.Posting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.GroupId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -11816,7 +14436,7 @@ func (m *MapEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
+func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11839,15 +14459,15 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: TypeUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TypeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11872,51 +14492,13 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			m.TypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceGroupId", wireType)
-			}
-			m.SourceGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceGroupId |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestGroupId", wireType)
-			}
-			m.DestGroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestGroupId |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11926,24 +14508,20 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11966,7 +14544,7 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExportPayload) Unmarshal(dAtA []byte) error {
+func (m *AnalyzerConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11989,17 +14567,17 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExportPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: AnalyzerConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExportPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AnalyzerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
 			}
-			m.ReqId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12009,16 +14587,26 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReqId |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopwords", wireType)
 			}
-			m.GroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12028,16 +14616,26 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stopwords = append(m.Stopwords, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomStopwords", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12047,16 +14645,75 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (ExportPayload_Status(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.CustomStopwords = bool(v != 0)
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynonymsFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SynonymsFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synonyms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Synonyms = append(m.Synonyms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoStem", wireType)
 			}
-			m.ReadTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12066,11 +14723,12 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.NoStem = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -12092,7 +14750,7 @@ func (m *ExportPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleDelta) Unmarshal(dAtA []byte) error {
+func (m *ChangeEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12115,15 +14773,15 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12147,143 +14805,75 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			var mapkey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				mapkey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if m.Commits == nil {
-				m.Commits = make(map[uint64]uint64)
+			if m.Edge == nil {
+				m.Edge = &DirectedEdge{}
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Commits[mapkey] = mapvalue
-			} else {
-				var mapvalue uint64
-				m.Commits[mapkey] = mapvalue
+			if err := m.Edge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			}
+			m.CommitTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInternal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Aborts = append(m.Aborts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogId", wireType)
+			}
+			m.LogId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				m.Aborts = append(m.Aborts, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aborts", wireType)
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			m.MaxPending = 0
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12293,7 +14883,7 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPending |= (uint64(b) & 0x7F) << shift
+				m.Seq |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12319,7 +14909,7 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
+func (m *ChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12342,73 +14932,68 @@ func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
+			}
+			m.SinceTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceTs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogId", wireType)
+			}
+			m.LogId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInternal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ts = append(m.Ts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterSeq", wireType)
+			}
+			m.AfterSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterSeq |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				m.Ts = append(m.Ts, v)
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -12431,7 +15016,7 @@ func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeerResponse) Unmarshal(dAtA []byte) error {
+func (m *Time) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12454,17 +15039,17 @@ func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Time: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Time: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixNano", wireType)
 			}
-			var v int
+			m.UnixNano = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12474,12 +15059,11 @@ func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.UnixNano |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -12501,7 +15085,7 @@ func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Num) Unmarshal(dAtA []byte) error {
+func (m *SortCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12524,17 +15108,48 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Num: wiretype end group for non-group")
+			return fmt.Errorf("proto: SortCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SortCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &TaskValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nulls", wireType)
 			}
-			m.Val = 0
+			m.Nulls = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12544,7 +15159,26 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Val |= (uint64(b) & 0x7F) << shift
+				m.Nulls |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uid |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12570,7 +15204,7 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
+func (m *TimeMark) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12593,17 +15227,17 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SnapshotMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeMark: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapshotMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeMark: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixNano", wireType)
 			}
-			m.ClientTs = 0
+			m.UnixNano = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12613,16 +15247,16 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientTs |= (uint64(b) & 0x7F) << shift
+				m.UnixNano |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			m.GroupId = 0
+			m.Ts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12632,7 +15266,7 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= (uint32(b) & 0x7F) << shift
+				m.Ts |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12766,194 +15400,228 @@ var (
 func init() { proto.RegisterFile("internal.proto", fileDescriptorInternal) }
 
 var fileDescriptorInternal = []byte{
-	// 3013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x39, 0xcb, 0x6e, 0x23, 0xc7,
-	0xb5, 0xec, 0x26, 0xd9, 0x6c, 0x1e, 0x92, 0x1a, 0xba, 0x3c, 0x9e, 0xa1, 0x69, 0x5f, 0x59, 0xae,
-	0xf1, 0xf5, 0xc8, 0x2f, 0xd9, 0x23, 0x8f, 0x1f, 0x77, 0xee, 0xf5, 0x0d, 0x64, 0x91, 0x1a, 0xd3,
-	0xa3, 0x97, 0x8b, 0x94, 0x1c, 0x67, 0x11, 0xa2, 0xc4, 0x2e, 0x51, 0x0d, 0x35, 0xbb, 0xdb, 0x5d,
-	0x4d, 0x81, 0xf2, 0x32, 0xbb, 0x20, 0x3f, 0xe0, 0x75, 0x80, 0xec, 0x02, 0x04, 0xc8, 0x32, 0x8b,
-	0xac, 0x12, 0x20, 0x8b, 0x00, 0x49, 0xfe, 0x20, 0x71, 0x36, 0x41, 0xb2, 0xca, 0x1f, 0x04, 0xf5,
-	0xe8, 0x07, 0x29, 0x8e, 0x2c, 0xe4, 0xb1, 0x62, 0x9f, 0x53, 0xe7, 0xd4, 0xe3, 0xbc, 0xcf, 0x21,
-	0xac, 0xb8, 0x7e, 0xcc, 0x22, 0x9f, 0x7a, 0x1b, 0x61, 0x14, 0xc4, 0x01, 0xb2, 0x14, 0xdc, 0xae,
-	0xd2, 0xd0, 0x55, 0x28, 0xdc, 0x86, 0xd2, 0xae, 0xcb, 0x63, 0x84, 0xa0, 0x34, 0x75, 0x1d, 0xde,
-	0x32, 0xd6, 0x8a, 0xeb, 0x16, 0x91, 0xdf, 0xf8, 0x33, 0xa8, 0x0e, 0x28, 0x3f, 0x3f, 0xa6, 0xde,
-	0x94, 0xa1, 0x26, 0x14, 0x2f, 0xa8, 0xd7, 0x32, 0xd6, 0x8c, 0xf5, 0x3a, 0x11, 0x9f, 0x68, 0x13,
-	0xec, 0x0b, 0xea, 0x0d, 0xe3, 0xcb, 0x90, 0xb5, 0xcc, 0x35, 0x63, 0x7d, 0x65, 0xf3, 0xee, 0x86,
-	0x3a, 0x60, 0xe3, 0x30, 0xe0, 0xb1, 0xeb, 0x8f, 0x37, 0x8e, 0xa9, 0x37, 0xb8, 0x0c, 0x19, 0xa9,
-	0x5c, 0xa8, 0x0f, 0x7c, 0x00, 0xb5, 0x7e, 0x34, 0xda, 0x99, 0xfa, 0xa3, 0xd8, 0x0d, 0x7c, 0x71,
-	0xaa, 0x4f, 0x27, 0x4c, 0xee, 0x5a, 0x25, 0xf2, 0x5b, 0xe0, 0x68, 0x34, 0xe6, 0xad, 0xe2, 0x5a,
-	0x51, 0xe0, 0xc4, 0x37, 0x6a, 0x41, 0xc5, 0xe5, 0xdb, 0xc1, 0xd4, 0x8f, 0x5b, 0xa5, 0x35, 0x63,
-	0xdd, 0x26, 0x09, 0x88, 0x7f, 0x5a, 0x84, 0xf2, 0x67, 0x53, 0x16, 0x5d, 0x4a, 0xbe, 0x38, 0x8e,
-	0x92, 0xbd, 0xc4, 0x37, 0xba, 0x0d, 0x65, 0x8f, 0xfa, 0x63, 0xde, 0x32, 0xe5, 0x66, 0x0a, 0x40,
-	0x2f, 0x40, 0x95, 0x9e, 0xc6, 0x2c, 0x1a, 0x4e, 0x5d, 0xa7, 0x55, 0x5c, 0x33, 0xd6, 0x2d, 0x62,
-	0x4b, 0xc4, 0x91, 0xeb, 0xa0, 0xe7, 0xc1, 0x76, 0x82, 0xe1, 0x28, 0x7f, 0x96, 0x13, 0xc8, 0xb3,
-	0xd0, 0x7d, 0xb0, 0xa7, 0xae, 0x33, 0xf4, 0x5c, 0x1e, 0xb7, 0xca, 0x6b, 0xc6, 0x7a, 0x6d, 0xb3,
-	0x9e, 0x3c, 0x58, 0xc8, 0x90, 0x54, 0xa6, 0xae, 0x23, 0x85, 0xb9, 0x01, 0x36, 0x8f, 0x46, 0xc3,
-	0xd3, 0xa9, 0x3f, 0x6a, 0x59, 0x92, 0xf0, 0xd9, 0x84, 0x30, 0xf7, 0x7a, 0x52, 0xe1, 0x0a, 0x10,
-	0xcf, 0x8b, 0xd8, 0x05, 0x8b, 0x38, 0x6b, 0x55, 0xd4, 0x91, 0x1a, 0x44, 0x0f, 0xa1, 0x76, 0x4a,
-	0x47, 0x2c, 0x1e, 0x86, 0x34, 0xa2, 0x93, 0x96, 0x3d, 0xbf, 0xd9, 0x8e, 0x58, 0x3a, 0x14, 0x2b,
-	0x9c, 0xc0, 0x69, 0x0a, 0xa0, 0x0f, 0xa0, 0x21, 0x21, 0x3e, 0x3c, 0x75, 0xbd, 0x98, 0x45, 0xad,
-	0xaa, 0xe4, 0x43, 0x29, 0x9f, 0xc4, 0x0e, 0x22, 0xc6, 0x48, 0x5d, 0x11, 0x2a, 0x0c, 0xfa, 0x2f,
-	0x00, 0x36, 0x0b, 0xa9, 0xef, 0x0c, 0xa9, 0xe7, 0xb5, 0x40, 0xde, 0xa5, 0xaa, 0x30, 0x5b, 0x9e,
-	0x87, 0xee, 0x8a, 0x7b, 0x52, 0x67, 0x18, 0xf3, 0x56, 0x63, 0xcd, 0x58, 0x2f, 0x11, 0x4b, 0x80,
-	0x03, 0x2e, 0x24, 0xe3, 0xb9, 0xfe, 0x50, 0x40, 0xad, 0x15, 0x2d, 0x19, 0x61, 0x63, 0xbb, 0xae,
-	0x4f, 0x18, 0x75, 0x48, 0xc5, 0x53, 0x1f, 0xf8, 0x7d, 0xa8, 0x4a, 0x73, 0x92, 0x62, 0x7a, 0x0d,
-	0xac, 0x0b, 0x01, 0x28, 0xab, 0xab, 0x6d, 0x3e, 0x93, 0xdc, 0x2f, 0xb5, 0x3a, 0xa2, 0x09, 0xf0,
-	0x2a, 0xd8, 0xbb, 0xd4, 0x1f, 0x27, 0xa6, 0x2a, 0xf4, 0x28, 0x99, 0xaa, 0x44, 0x7e, 0xe3, 0x3f,
-	0x98, 0x60, 0x11, 0xc6, 0xa7, 0x5e, 0x8c, 0xde, 0x00, 0x10, 0x5a, 0x9a, 0xd0, 0x38, 0x72, 0x67,
-	0x7a, 0xe7, 0x79, 0x3d, 0x55, 0xa7, 0xae, 0xb3, 0x27, 0x97, 0xd1, 0x43, 0xa8, 0xcb, 0x13, 0x12,
-	0x72, 0x73, 0xfe, 0x22, 0xe9, 0x5d, 0x49, 0x4d, 0x92, 0x69, 0xae, 0x3b, 0x60, 0x49, 0x03, 0x51,
-	0x46, 0xda, 0x20, 0x1a, 0x42, 0xff, 0xad, 0x3d, 0x8e, 0xb3, 0x51, 0x3c, 0x74, 0x18, 0x4f, 0x2c,
-	0xa8, 0x91, 0x62, 0x3b, 0x8c, 0xc7, 0xe8, 0x3d, 0x50, 0x52, 0x4f, 0x0e, 0x2d, 0xcb, 0x43, 0xd1,
-	0x9c, 0x56, 0xb9, 0x3a, 0x55, 0xd2, 0xe9, 0x53, 0x1f, 0x40, 0x4d, 0xbc, 0x35, 0xe1, 0xb2, 0x24,
-	0x57, 0x33, 0x7d, 0x99, 0x16, 0x0f, 0x01, 0x41, 0xa4, 0x59, 0x84, 0xa8, 0x84, 0xb5, 0x2a, 0xab,
-	0x92, 0xdf, 0x37, 0xd7, 0x55, 0x17, 0xca, 0x07, 0x91, 0xc3, 0xa2, 0xa5, 0x9e, 0x85, 0xa0, 0xe4,
-	0x30, 0x3e, 0x92, 0x8e, 0x6f, 0x13, 0xf9, 0x9d, 0x79, 0x5b, 0x31, 0xe7, 0x6d, 0xf8, 0x77, 0x06,
-	0xd4, 0xfa, 0x41, 0x14, 0xef, 0x31, 0xce, 0xe9, 0x98, 0xa1, 0x7b, 0x50, 0x0e, 0xc4, 0xb6, 0x5a,
-	0x35, 0x8d, 0xe4, 0x01, 0xf2, 0x2c, 0xa2, 0xd6, 0x16, 0x94, 0x68, 0x5e, 0xaf, 0xc4, 0xdb, 0x50,
-	0x56, 0xfe, 0x2a, 0x7c, 0xb9, 0x4c, 0x14, 0x20, 0x94, 0x14, 0x9c, 0x9e, 0x72, 0xa6, 0x94, 0x50,
-	0x26, 0x1a, 0xfa, 0x37, 0x18, 0xf1, 0x09, 0x80, 0x78, 0xd0, 0x3f, 0x63, 0x6f, 0x37, 0x3e, 0xe3,
-	0x0c, 0x6a, 0x84, 0x9e, 0xc6, 0xdb, 0x81, 0x1f, 0xb3, 0x59, 0x8c, 0x56, 0xc0, 0x74, 0x1d, 0xa9,
-	0x00, 0x8b, 0x98, 0xae, 0x23, 0x9e, 0x3c, 0x8e, 0x82, 0x69, 0x28, 0xe5, 0xdf, 0x20, 0x0a, 0x90,
-	0x8a, 0x72, 0x9c, 0x48, 0xca, 0x41, 0x28, 0xca, 0x71, 0x22, 0xf4, 0x12, 0xd4, 0xb8, 0x4f, 0x43,
-	0x7e, 0x16, 0xc4, 0xe2, 0xc9, 0x25, 0xf9, 0x64, 0x48, 0x50, 0x03, 0x8e, 0x7f, 0x6d, 0x80, 0xb5,
-	0xc7, 0x26, 0x27, 0x2c, 0xba, 0x72, 0xca, 0xf3, 0x60, 0xcb, 0x8d, 0x87, 0xae, 0xa3, 0x0f, 0xaa,
-	0x48, 0xb8, 0xe7, 0x2c, 0x3d, 0xea, 0x0e, 0x58, 0x1e, 0xa3, 0x42, 0xb5, 0xca, 0xec, 0x35, 0x24,
-	0x24, 0x4e, 0x27, 0x43, 0x47, 0xbc, 0xb9, 0xac, 0x16, 0xe8, 0xa4, 0xc3, 0xa8, 0x23, 0xee, 0xe6,
-	0x51, 0x1e, 0x0f, 0xa7, 0xa1, 0x43, 0x63, 0x26, 0x43, 0x65, 0x49, 0xd8, 0x2f, 0x8f, 0x8f, 0x24,
-	0x06, 0xbd, 0x0e, 0xcf, 0x8c, 0xbc, 0x29, 0x17, 0xb1, 0xda, 0xf5, 0x4f, 0x83, 0x61, 0xe0, 0x7b,
-	0x97, 0x52, 0x6b, 0x36, 0xb9, 0xa5, 0x17, 0x7a, 0xfe, 0x69, 0x70, 0xe0, 0x7b, 0x97, 0xf8, 0x47,
-	0x26, 0x94, 0x1f, 0x4b, 0x31, 0x3c, 0x84, 0xca, 0x44, 0x3e, 0x28, 0x09, 0x2c, 0xed, 0x44, 0x1d,
-	0x72, 0x7d, 0x43, 0xbd, 0x96, 0x77, 0xfd, 0x38, 0xba, 0x24, 0x09, 0xa9, 0xe0, 0x8a, 0xe9, 0x89,
-	0xc7, 0x62, 0xae, 0xed, 0x6d, 0x81, 0x6b, 0xa0, 0x16, 0x35, 0x97, 0x26, 0x6d, 0x7f, 0x0a, 0xf5,
-	0xfc, 0x76, 0x22, 0x4d, 0x9e, 0xb3, 0x4b, 0x29, 0xc3, 0x12, 0x11, 0x9f, 0xe8, 0x15, 0x28, 0xcb,
-	0xd8, 0x21, 0x25, 0x58, 0xdb, 0x5c, 0x49, 0x76, 0x55, 0x6c, 0x44, 0x2d, 0x3e, 0x32, 0x3f, 0x34,
-	0xc4, 0x5e, 0xf9, 0x43, 0xf2, 0x7b, 0x55, 0xaf, 0xdf, 0x4b, 0xb1, 0xe5, 0xf6, 0xc2, 0x7f, 0x33,
-	0xa0, 0xfe, 0x3d, 0x16, 0x05, 0x87, 0x51, 0x10, 0x06, 0x9c, 0x7a, 0x39, 0xdd, 0x36, 0xa4, 0x6e,
-	0x5f, 0x05, 0x4b, 0xbd, 0xfc, 0x29, 0xf7, 0xd2, 0xab, 0x82, 0x4e, 0xbd, 0x55, 0xaa, 0xfa, 0xea,
-	0x99, 0x7a, 0x15, 0xad, 0x02, 0x4c, 0xe8, 0x6c, 0x97, 0x51, 0xce, 0x7a, 0x4e, 0x62, 0x66, 0x19,
-	0x06, 0xb5, 0xc1, 0x9e, 0xd0, 0xd9, 0x60, 0xe6, 0x0f, 0xb8, 0xb4, 0x82, 0x12, 0x49, 0x61, 0xf4,
-	0x22, 0x54, 0x27, 0x74, 0x26, 0xec, 0xbd, 0xe7, 0x68, 0x2b, 0xc8, 0x10, 0xe8, 0x65, 0x28, 0xc6,
-	0x33, 0x5f, 0xc6, 0xb0, 0xda, 0xe6, 0x2d, 0xe9, 0x2e, 0x83, 0x99, 0xaf, 0x3d, 0x83, 0x88, 0x35,
-	0xfc, 0x8b, 0x22, 0xdc, 0xd2, 0x6a, 0x38, 0x73, 0xc3, 0x7e, 0x2c, 0x6c, 0xa7, 0x05, 0x15, 0x19,
-	0x08, 0x58, 0xa4, 0xb5, 0x91, 0x80, 0xe8, 0x7f, 0xc1, 0x92, 0x66, 0x9c, 0x28, 0xfa, 0xde, 0xfc,
-	0xd3, 0xd3, 0x2d, 0x94, 0xe2, 0xb5, 0xc6, 0x35, 0x0b, 0xfa, 0x10, 0xca, 0x5f, 0xb1, 0x28, 0x50,
-	0x41, 0xae, 0xb6, 0x89, 0x9f, 0xc6, 0x2b, 0x84, 0xaf, 0x59, 0x15, 0xc3, 0x7f, 0x50, 0x42, 0xeb,
-	0x22, 0xa4, 0x4d, 0x82, 0x0b, 0xe6, 0xb4, 0x2a, 0xf2, 0x56, 0x8b, 0xca, 0x4c, 0x96, 0xdb, 0x9f,
-	0x40, 0x2d, 0xf7, 0xa8, 0xbc, 0x85, 0x35, 0x94, 0x85, 0xdd, 0x9b, 0xb7, 0xb0, 0xc6, 0x9c, 0x0f,
-	0xe4, 0x8d, 0xf5, 0x13, 0x80, 0xec, 0x89, 0xff, 0x8a, 0xd9, 0xe3, 0x1f, 0x1a, 0x70, 0x6b, 0x3b,
-	0xf0, 0x7d, 0x26, 0xab, 0x22, 0xa5, 0xbc, 0xcc, 0x3a, 0x8d, 0x6b, 0xad, 0xf3, 0x2d, 0x28, 0x73,
-	0xc1, 0xa0, 0x4f, 0xb9, 0xfb, 0x14, 0x6d, 0x10, 0x45, 0x25, 0x02, 0xce, 0x84, 0xce, 0x86, 0x21,
-	0xf3, 0x1d, 0xd7, 0x1f, 0x4b, 0x8b, 0x56, 0x3a, 0x38, 0x54, 0x18, 0xfc, 0x63, 0x03, 0x2c, 0x65,
-	0xd8, 0x73, 0xc1, 0xcf, 0x98, 0x0f, 0x7e, 0x2f, 0x42, 0x35, 0x8c, 0x98, 0xe3, 0x8e, 0x92, 0x93,
-	0xab, 0x24, 0x43, 0x88, 0xd8, 0x7c, 0x1a, 0x44, 0x23, 0x26, 0xb7, 0xb7, 0x89, 0x02, 0x44, 0xd1,
-	0x29, 0xd3, 0x8e, 0x0c, 0x61, 0x2a, 0x3e, 0xda, 0x02, 0x21, 0x62, 0x97, 0x60, 0xe1, 0x21, 0x1d,
-	0xa9, 0xf2, 0xaf, 0x48, 0x14, 0x20, 0xe2, 0xa9, 0xd2, 0x9b, 0xac, 0xfb, 0x6c, 0xa2, 0x21, 0xfc,
-	0x73, 0x13, 0xea, 0x1d, 0x37, 0x62, 0xa3, 0x98, 0x39, 0x5d, 0x67, 0x2c, 0x09, 0x99, 0x1f, 0xbb,
-	0xf1, 0xa5, 0x8e, 0xdd, 0x1a, 0x4a, 0x13, 0xb7, 0x39, 0x5f, 0x12, 0x2b, 0xbd, 0x14, 0x65, 0x25,
-	0xaf, 0x00, 0xf4, 0x3e, 0x80, 0xaa, 0x83, 0x64, 0x35, 0x5f, 0xba, 0xbe, 0x9a, 0xaf, 0x4a, 0x52,
-	0xf1, 0x29, 0x84, 0xa4, 0xf8, 0x5c, 0x15, 0xdb, 0x2d, 0x59, 0xea, 0x4f, 0x85, 0x39, 0xcb, 0x6a,
-	0xe0, 0x84, 0x79, 0xd2, 0x5c, 0x65, 0x35, 0x70, 0xc2, 0xbc, 0xb4, 0x78, 0xab, 0xa8, 0x2b, 0x89,
-	0x6f, 0x74, 0x1f, 0xcc, 0x20, 0x94, 0x6f, 0xcc, 0x1d, 0x9a, 0x7f, 0xe0, 0xc6, 0x41, 0x48, 0xcc,
-	0x20, 0x44, 0x18, 0x2c, 0x55, 0xae, 0xb6, 0xaa, 0xd2, 0xcc, 0x41, 0x06, 0x03, 0x59, 0x2f, 0x11,
-	0xbd, 0x82, 0xef, 0x80, 0x79, 0x10, 0xa2, 0x0a, 0x14, 0xfb, 0xdd, 0x41, 0xb3, 0x20, 0x3e, 0x3a,
-	0xdd, 0xdd, 0xa6, 0x81, 0xff, 0x6a, 0x40, 0x75, 0x6f, 0x1a, 0x53, 0x61, 0x63, 0xfc, 0x3a, 0xe5,
-	0x3e, 0x0f, 0x36, 0x8f, 0x69, 0x24, 0xb3, 0xa5, 0xa9, 0x02, 0x87, 0x84, 0x07, 0x1c, 0xbd, 0x0e,
-	0x65, 0xe6, 0x8c, 0x59, 0xe2, 0xfb, 0xb7, 0x97, 0xdd, 0x95, 0x28, 0x12, 0xf4, 0x26, 0x58, 0x7c,
-	0x74, 0xc6, 0x26, 0xb4, 0x55, 0x9a, 0x27, 0xee, 0x4b, 0xac, 0x4a, 0x70, 0x44, 0xd3, 0xc8, 0xae,
-	0x23, 0x0a, 0x42, 0x59, 0x76, 0x97, 0x75, 0xd7, 0x11, 0x05, 0xa1, 0x28, 0xba, 0x37, 0xe1, 0x39,
-	0x77, 0xec, 0x07, 0x11, 0x1b, 0xba, 0xbe, 0xc3, 0x66, 0xc3, 0x51, 0xe0, 0x9f, 0x7a, 0xee, 0x28,
-	0x96, 0x72, 0xb5, 0xc9, 0xb3, 0x6a, 0xb1, 0x27, 0xd6, 0xb6, 0xf5, 0x12, 0xbe, 0x0f, 0xd5, 0x27,
-	0xec, 0x52, 0x56, 0xaf, 0x1c, 0xb5, 0xc1, 0x3c, 0xbf, 0xd0, 0x99, 0x10, 0x92, 0x5b, 0x3c, 0x39,
-	0x26, 0xe6, 0xf9, 0x05, 0xfe, 0xda, 0x04, 0x3b, 0x4d, 0x11, 0xf7, 0xa0, 0xe1, 0xb0, 0x30, 0x62,
-	0xc2, 0x8a, 0x9d, 0x4c, 0x32, 0xf5, 0x0c, 0xd9, 0x73, 0xd0, 0xdb, 0x50, 0x9d, 0x24, 0x62, 0xd4,
-	0x5e, 0x97, 0x96, 0xcb, 0xa9, 0x7c, 0x49, 0x46, 0x83, 0xde, 0x81, 0x5a, 0x3c, 0xf3, 0xc5, 0xb5,
-	0x45, 0xbc, 0xd6, 0x59, 0xe4, 0x4a, 0x18, 0x87, 0x38, 0xfd, 0xd6, 0x17, 0x2e, 0x2d, 0xbb, 0x70,
-	0xe6, 0xf0, 0xe5, 0x1b, 0x39, 0xfc, 0x7d, 0xb8, 0x35, 0xf2, 0x18, 0xf5, 0x87, 0x99, 0xbf, 0x2a,
-	0x73, 0x5c, 0x91, 0xe8, 0xc3, 0xd4, 0x69, 0x75, 0x00, 0xab, 0xa4, 0xb9, 0x16, 0xbf, 0x0c, 0xc5,
-	0x27, 0xc7, 0xfd, 0x6b, 0xa5, 0xf7, 0x7d, 0x30, 0x9f, 0x1c, 0xe7, 0x63, 0x5f, 0x5d, 0xc5, 0x3e,
-	0xdd, 0x2b, 0x9b, 0x59, 0xaf, 0xdc, 0x06, 0x7b, 0xca, 0x59, 0xb4, 0xc7, 0x62, 0xaa, 0x1d, 0x2f,
-	0x85, 0x45, 0xa2, 0x12, 0xcd, 0x9e, 0x1b, 0xf8, 0x3a, 0x29, 0x24, 0x20, 0xfe, 0x7b, 0x11, 0x2a,
-	0xda, 0xf9, 0xc4, 0x9e, 0xd3, 0xb4, 0x38, 0x13, 0x9f, 0x99, 0x27, 0x9b, 0x79, 0x4f, 0xce, 0x77,
-	0xe5, 0xc5, 0x9b, 0x75, 0xe5, 0xe8, 0xff, 0xa1, 0x1e, 0xaa, 0xb5, 0xbc, 0xff, 0xbf, 0xb0, 0xc8,
-	0xa7, 0x7f, 0x25, 0x6f, 0x2d, 0xcc, 0x00, 0x61, 0xbd, 0xb2, 0x33, 0x89, 0xe9, 0x58, 0xea, 0xa5,
-	0x4e, 0x2a, 0x02, 0x1e, 0xd0, 0xf1, 0x53, 0xa2, 0xc0, 0x0d, 0x1c, 0x59, 0x14, 0x2c, 0x41, 0xd8,
-	0xaa, 0xab, 0x82, 0x25, 0x08, 0xe7, 0xfc, 0xb2, 0x31, 0xef, 0x97, 0x2f, 0x40, 0x75, 0x14, 0x4c,
-	0x26, 0xae, 0x5c, 0x5b, 0x51, 0xa9, 0x53, 0x21, 0x06, 0x1c, 0x7f, 0x05, 0x15, 0xfd, 0x60, 0x54,
-	0x83, 0x4a, 0xa7, 0xbb, 0xb3, 0x75, 0xb4, 0x2b, 0x22, 0x03, 0x80, 0xf5, 0x71, 0x6f, 0x7f, 0x8b,
-	0x7c, 0xd1, 0x34, 0x44, 0x94, 0xe8, 0xed, 0x0f, 0x9a, 0x26, 0xaa, 0x42, 0x79, 0x67, 0xf7, 0x60,
-	0x6b, 0xd0, 0x2c, 0x22, 0x1b, 0x4a, 0x1f, 0x1f, 0x1c, 0xec, 0x36, 0x4b, 0xa8, 0x0e, 0x76, 0x67,
-	0x6b, 0xd0, 0x1d, 0xf4, 0xf6, 0xba, 0xcd, 0xb2, 0xa0, 0x7d, 0xdc, 0x3d, 0x68, 0x5a, 0xe2, 0xe3,
-	0xa8, 0xd7, 0x69, 0x56, 0xc4, 0xfa, 0xe1, 0x56, 0xbf, 0xff, 0xf9, 0x01, 0xe9, 0x34, 0x6d, 0xb1,
-	0x6f, 0x7f, 0x40, 0x7a, 0xfb, 0x8f, 0x9b, 0x55, 0xfc, 0x00, 0x6a, 0x39, 0xa1, 0x09, 0x0e, 0xd2,
-	0xdd, 0x69, 0x16, 0xc4, 0x31, 0xc7, 0x5b, 0xbb, 0x47, 0xdd, 0xa6, 0x81, 0x56, 0x00, 0xe4, 0xe7,
-	0x70, 0x77, 0x6b, 0xff, 0x71, 0xd3, 0xc4, 0x3f, 0x30, 0x52, 0x1e, 0xd9, 0xed, 0xbe, 0x01, 0xb6,
-	0x16, 0x75, 0x52, 0xcd, 0xde, 0x5a, 0xd0, 0x0b, 0x49, 0x09, 0x84, 0x99, 0x8d, 0xce, 0xd8, 0xe8,
-	0x9c, 0x4f, 0x27, 0xda, 0x2a, 0x52, 0x58, 0x35, 0xad, 0x42, 0x26, 0x3a, 0xed, 0x69, 0x28, 0x9d,
-	0xfc, 0x94, 0x24, 0xbd, 0x9a, 0xfc, 0x3c, 0x04, 0xc8, 0x66, 0x0b, 0x4b, 0xea, 0xd0, 0xdb, 0x50,
-	0xa6, 0x9e, 0x4b, 0xb9, 0xce, 0x2c, 0x0a, 0xc0, 0x04, 0x6a, 0xb9, 0x89, 0x84, 0x50, 0x18, 0xf5,
-	0xbc, 0xe1, 0x39, 0xbb, 0xe4, 0x92, 0xd7, 0x26, 0x15, 0xea, 0x79, 0x4f, 0xd8, 0x25, 0x47, 0xeb,
-	0x50, 0x56, 0x03, 0x0d, 0x73, 0x49, 0xeb, 0x2b, 0xd9, 0x89, 0x22, 0xc0, 0x6f, 0x82, 0xa5, 0xfa,
-	0xe1, 0x9c, 0xcd, 0x18, 0x4f, 0x0d, 0xfe, 0x1f, 0xe9, 0x7b, 0xcb, 0xee, 0x19, 0xbd, 0xad, 0x87,
-	0x27, 0x5c, 0x8d, 0x6c, 0x8c, 0xf9, 0xd2, 0x48, 0x11, 0xea, 0xb9, 0x89, 0x64, 0xc0, 0x1d, 0xb0,
-	0xaf, 0x1d, 0x4d, 0x69, 0x41, 0x98, 0x99, 0x20, 0x96, 0x0c, 0xab, 0x70, 0x04, 0x90, 0x0d, 0x58,
-	0xb4, 0x19, 0xab, 0x5d, 0x84, 0x19, 0x6f, 0x08, 0x15, 0xb9, 0x9e, 0x13, 0x31, 0xff, 0xca, 0xeb,
-	0xb3, 0xb1, 0x4c, 0x4a, 0x83, 0x5e, 0x81, 0x92, 0x9c, 0x23, 0xa9, 0xb8, 0x99, 0xb6, 0xfb, 0xe9,
-	0x10, 0x49, 0xae, 0xe2, 0x13, 0x68, 0xa8, 0xbc, 0x42, 0xd8, 0x97, 0x53, 0xc6, 0xaf, 0xad, 0x5e,
-	0x56, 0x01, 0xd2, 0x68, 0x98, 0x4c, 0xc6, 0x72, 0x18, 0x61, 0x28, 0xa7, 0x2e, 0xf3, 0x9c, 0xe4,
-	0x55, 0x1a, 0xc2, 0x1f, 0x40, 0x3d, 0x39, 0x43, 0x36, 0xbe, 0xf7, 0xd3, 0x0c, 0x97, 0xd8, 0xa5,
-	0x50, 0x88, 0x22, 0xd9, 0x0f, 0x9c, 0x34, 0xb9, 0xe1, 0x3f, 0x99, 0x09, 0xa7, 0x6e, 0xeb, 0xe6,
-	0xea, 0x27, 0x63, 0xb1, 0x7e, 0x9a, 0xaf, 0x45, 0xcc, 0x1b, 0xd7, 0x22, 0xff, 0x07, 0x55, 0x47,
-	0x26, 0x62, 0xf7, 0x22, 0x09, 0x7d, 0xab, 0xcb, 0x92, 0xae, 0x4e, 0xd7, 0xee, 0x05, 0x23, 0x19,
-	0x83, 0xb8, 0x53, 0x1c, 0x9c, 0x33, 0xdf, 0xfd, 0x4a, 0xf6, 0xaf, 0xe2, 0xe1, 0x19, 0x22, 0x1b,
-	0x31, 0xa8, 0xe4, 0xac, 0x47, 0x0c, 0xc9, 0x78, 0xc5, 0xca, 0x8d, 0x57, 0xee, 0x80, 0x35, 0x0d,
-	0x39, 0x8b, 0xe2, 0xa4, 0x68, 0x53, 0x50, 0x5a, 0xf8, 0x54, 0x35, 0x2d, 0xf5, 0xc7, 0xf8, 0x7f,
-	0xa0, 0x9a, 0xde, 0x45, 0xc4, 0x9b, 0xfd, 0x83, 0xfd, 0xae, 0x8a, 0x0e, 0xbd, 0xfd, 0x4e, 0xf7,
-	0xbb, 0x4d, 0x43, 0x44, 0x2c, 0xd2, 0x3d, 0xee, 0x92, 0x7e, 0xb7, 0x69, 0x8a, 0xc8, 0xd2, 0xe9,
-	0xee, 0x76, 0x07, 0xdd, 0x66, 0xf1, 0xd3, 0x92, 0x5d, 0x69, 0xda, 0xc4, 0x66, 0xb3, 0xd0, 0x73,
-	0x47, 0x6e, 0x8c, 0xbf, 0x00, 0x7b, 0x8f, 0x86, 0x57, 0x8a, 0xf1, 0x2c, 0x21, 0x4d, 0x75, 0x0f,
-	0xaf, 0x93, 0xc7, 0x6b, 0x50, 0xd1, 0x51, 0x23, 0xcd, 0xc8, 0x0b, 0x51, 0x25, 0x59, 0xc7, 0x3f,
-	0x33, 0xe0, 0xf6, 0x5e, 0x70, 0xc1, 0xd2, 0x64, 0x79, 0x48, 0x2f, 0xbd, 0x80, 0x3a, 0xdf, 0xa2,
-	0xc6, 0x57, 0xe1, 0x16, 0x0f, 0xa6, 0xd1, 0x88, 0x0d, 0x17, 0x66, 0x08, 0x0d, 0x85, 0x7e, 0xac,
-	0xcd, 0x11, 0x8b, 0xaa, 0x83, 0xc7, 0x19, 0x55, 0x51, 0x52, 0xd5, 0x04, 0x32, 0xa1, 0x49, 0xb3,
-	0x7e, 0xe9, 0x26, 0x59, 0x1f, 0xff, 0xd6, 0x80, 0x46, 0x77, 0x16, 0x06, 0x51, 0x9c, 0x5c, 0xf5,
-	0x39, 0x51, 0x4a, 0x7f, 0x99, 0x38, 0x43, 0x89, 0x94, 0x23, 0xf6, 0x65, 0xef, 0xda, 0x01, 0xc7,
-	0x43, 0xb0, 0xc4, 0x66, 0x53, 0xae, 0x4d, 0xe9, 0xc5, 0xe4, 0xcc, 0xb9, 0x8d, 0x37, 0xfa, 0x92,
-	0x86, 0x68, 0xda, 0xfc, 0x70, 0xa9, 0x94, 0x1f, 0x2e, 0xe1, 0x47, 0x60, 0x29, 0xd2, 0x9c, 0x9e,
-	0x6b, 0x50, 0xe9, 0x1f, 0x6d, 0x6f, 0x77, 0xfb, 0xfd, 0xa6, 0x81, 0x1a, 0x50, 0xed, 0x1c, 0x1d,
-	0xee, 0xf6, 0xb6, 0xb7, 0x06, 0x5a, 0xd7, 0x3b, 0x5b, 0xbd, 0xdd, 0x6e, 0xa7, 0x59, 0xc4, 0xbf,
-	0x34, 0xa0, 0x76, 0x10, 0xd1, 0x91, 0xc7, 0x3a, 0xcc, 0x8b, 0x29, 0x7a, 0x24, 0x3a, 0x5b, 0x11,
-	0xbb, 0x93, 0x50, 0xb8, 0x96, 0xcd, 0xd0, 0x52, 0xaa, 0x8d, 0x6d, 0x45, 0xa2, 0xe7, 0x15, 0x9a,
-	0x41, 0x98, 0x27, 0x3d, 0x09, 0x22, 0x3d, 0xe4, 0x28, 0x11, 0x0d, 0x7d, 0x6b, 0x67, 0xd4, 0x7e,
-	0x04, 0xf5, 0xfc, 0x8e, 0x4b, 0x3a, 0xbe, 0xb9, 0x7a, 0xa4, 0x94, 0xef, 0xf0, 0x5e, 0x82, 0x86,
-	0x68, 0x63, 0xdd, 0x09, 0xe3, 0x31, 0x9d, 0x84, 0x32, 0xb7, 0xeb, 0xcb, 0x97, 0x88, 0x19, 0x73,
-	0xfc, 0x2a, 0xd4, 0x0f, 0x19, 0x8b, 0x08, 0xe3, 0x61, 0xe0, 0x73, 0xd9, 0xd0, 0x68, 0xe1, 0xab,
-	0xc4, 0xa1, 0x21, 0x7c, 0x17, 0x8a, 0xfb, 0xd3, 0x49, 0xfe, 0xbf, 0x88, 0x92, 0xac, 0xaf, 0xf0,
-	0x0e, 0xd4, 0xfb, 0x7a, 0xa4, 0x25, 0x6b, 0x2a, 0x51, 0x11, 0x78, 0x2e, 0xf3, 0x65, 0x45, 0x60,
-	0xe8, 0x8a, 0x40, 0x22, 0x06, 0xfc, 0x1a, 0xad, 0x6f, 0xfe, 0xca, 0x80, 0x92, 0x68, 0xaa, 0x45,
-	0xd8, 0xed, 0x8e, 0xce, 0x02, 0xa4, 0xc6, 0x73, 0x5a, 0xdb, 0xed, 0x39, 0x08, 0x17, 0xd0, 0x1b,
-	0x6a, 0x4a, 0x97, 0x8c, 0x36, 0xaf, 0x27, 0xde, 0x84, 0xda, 0xa7, 0x81, 0xeb, 0x6f, 0xab, 0xb9,
-	0x15, 0x4a, 0xa7, 0xf8, 0xb9, 0x39, 0xdf, 0x15, 0x9e, 0xf7, 0xc0, 0xea, 0x71, 0x21, 0x9a, 0xe5,
-	0xe4, 0x69, 0x53, 0x91, 0x97, 0x1e, 0x2e, 0x6c, 0xfe, 0xa4, 0x08, 0x25, 0xd1, 0x9d, 0xa3, 0x87,
-	0x50, 0xd1, 0xad, 0x35, 0x5a, 0x68, 0xa1, 0xdb, 0xa9, 0x13, 0x2d, 0xf4, 0xde, 0xb8, 0x80, 0xde,
-	0x07, 0x4b, 0x47, 0xea, 0xf9, 0xfe, 0xbf, 0xfd, 0x34, 0xc7, 0xc3, 0x85, 0x75, 0xe3, 0x1d, 0x03,
-	0xbd, 0x0d, 0x96, 0xb2, 0xc0, 0x05, 0x49, 0x3c, 0xbb, 0xc4, 0x3e, 0x71, 0x41, 0x32, 0xd4, 0xfa,
-	0x67, 0xc1, 0xd4, 0x73, 0xfa, 0x2c, 0xba, 0x60, 0x68, 0x61, 0xb6, 0xd4, 0x5e, 0x80, 0x71, 0x01,
-	0xbd, 0x05, 0xb0, 0xc5, 0xb9, 0x3b, 0xf6, 0x8f, 0x5c, 0x87, 0xa3, 0x5a, 0xb2, 0xbe, 0x3f, 0x9d,
-	0xb4, 0x9b, 0xf2, 0x48, 0xb5, 0x2a, 0x3a, 0x15, 0xae, 0xc8, 0x73, 0x56, 0xf7, 0xad, 0xe4, 0xef,
-	0x42, 0x43, 0xd9, 0xf8, 0x41, 0xb4, 0x25, 0xdc, 0x02, 0x2d, 0xb6, 0x29, 0xed, 0x45, 0x04, 0x2e,
-	0xa0, 0x47, 0x60, 0x0f, 0xa2, 0x4b, 0x45, 0xff, 0x5c, 0x7a, 0xe1, 0xbc, 0xb9, 0xb7, 0x97, 0xa3,
-	0x71, 0x61, 0xf3, 0x2f, 0x45, 0xb0, 0x3e, 0x0f, 0xa2, 0x73, 0x16, 0xa1, 0x0d, 0xb0, 0x64, 0xfb,
-	0xc4, 0xd0, 0xd5, 0x76, 0x6a, 0xd9, 0xb1, 0x6f, 0x42, 0x55, 0x0a, 0x6d, 0x40, 0xf9, 0x79, 0xa6,
-	0x26, 0xf9, 0x57, 0x58, 0x26, 0x37, 0x95, 0xa9, 0x71, 0x01, 0x7d, 0x07, 0xee, 0xa4, 0xe1, 0x7b,
-	0xcb, 0x77, 0x54, 0x3a, 0xec, 0xd0, 0x98, 0xa2, 0xac, 0x2f, 0xcd, 0xf9, 0x4f, 0xbb, 0x96, 0x75,
-	0x3a, 0x7d, 0xa9, 0xa9, 0x07, 0x50, 0xea, 0x8b, 0x17, 0x66, 0x7f, 0x64, 0x65, 0x23, 0xfd, 0x36,
-	0xca, 0x23, 0xd3, 0x33, 0x3f, 0x00, 0x4b, 0x9d, 0x93, 0x89, 0x65, 0xae, 0x46, 0x69, 0xdf, 0x5e,
-	0x44, 0x6b, 0xc6, 0xfb, 0x60, 0xef, 0xb9, 0xbe, 0x1a, 0x7c, 0xcd, 0x1b, 0x52, 0x5e, 0x83, 0xb8,
-	0x80, 0x3e, 0x04, 0x4b, 0x45, 0xe3, 0xec, 0x84, 0xb9, 0xe8, 0xdc, 0x5e, 0x8e, 0xc6, 0x05, 0xf4,
-	0x00, 0x9a, 0x84, 0x8d, 0x98, 0x9b, 0xcb, 0x6a, 0x28, 0xff, 0xe6, 0x45, 0x47, 0x5c, 0x37, 0xd0,
-	0x47, 0xd0, 0x98, 0xcb, 0x82, 0x28, 0xcd, 0x08, 0xcb, 0x92, 0xe3, 0xe2, 0x06, 0x1f, 0x37, 0x7f,
-	0xf3, 0xcd, 0xaa, 0xf1, 0xfb, 0x6f, 0x56, 0x8d, 0x3f, 0x7e, 0xb3, 0x6a, 0x7c, 0xfd, 0xe7, 0xd5,
-	0xc2, 0x89, 0x25, 0xff, 0x81, 0x7d, 0xf7, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x30, 0xc1,
-	0x11, 0xa6, 0x1d, 0x00, 0x00,
+	// 3561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb5, 0x5a, 0x49, 0x93, 0x23, 0x57,
+	0x11, 0x1e, 0xed, 0x52, 0x4a, 0xea, 0x91, 0x6b, 0x36, 0x21, 0x9b, 0xb1, 0xa9, 0x01, 0xcf, 0x78,
+	0x6b, 0xec, 0xf6, 0x78, 0x61, 0xc0, 0x10, 0x9a, 0x6e, 0xcd, 0x58, 0x9e, 0xde, 0x5c, 0x52, 0xb7,
+	0x31, 0x07, 0x14, 0xd5, 0xd2, 0xeb, 0xee, 0x8a, 0x29, 0x55, 0xc9, 0xf5, 0x4a, 0x4d, 0xb7, 0x23,
+	0xb8, 0x70, 0x20, 0x82, 0x70, 0x70, 0x83, 0x08, 0x07, 0xc1, 0x89, 0x3f, 0xc0, 0x9d, 0x03, 0x27,
+	0x08, 0x38, 0xf8, 0x60, 0xfe, 0x01, 0x01, 0x17, 0x22, 0xf8, 0x13, 0x64, 0xe6, 0x7b, 0xb5, 0x69,
+	0x34, 0x3d, 0xc3, 0x76, 0x98, 0x98, 0xca, 0x7c, 0xf9, 0xb6, 0x5c, 0xbe, 0xcc, 0x97, 0x6a, 0x58,
+	0x71, 0xbc, 0x50, 0x04, 0x9e, 0xed, 0xae, 0xce, 0x02, 0x3f, 0xf4, 0x8d, 0xb2, 0xa2, 0x3b, 0x35,
+	0x7b, 0xe6, 0x28, 0x96, 0xd9, 0x81, 0xe2, 0xa6, 0x23, 0x43, 0xc3, 0x80, 0xe2, 0xdc, 0x99, 0xc8,
+	0x76, 0xee, 0x85, 0xc2, 0xad, 0xb2, 0xc5, 0xdf, 0xe6, 0x87, 0x50, 0x1b, 0xda, 0xf2, 0xe1, 0xbe,
+	0xed, 0xce, 0x85, 0xd1, 0x82, 0xc2, 0x89, 0xed, 0xe2, 0x78, 0xee, 0x56, 0xc3, 0xa2, 0x4f, 0x63,
+	0x0d, 0xaa, 0xf8, 0xdf, 0x28, 0x3c, 0x9b, 0x89, 0x76, 0x1e, 0xd9, 0x2b, 0x6b, 0xd7, 0x56, 0xd5,
+	0x06, 0xab, 0xbb, 0xbe, 0x0c, 0x1d, 0xef, 0x68, 0x15, 0xa7, 0x0e, 0x71, 0xd8, 0xaa, 0x9c, 0xa8,
+	0x0f, 0x73, 0x07, 0xea, 0x83, 0x60, 0x7c, 0x6f, 0xee, 0x8d, 0x43, 0xc7, 0xf7, 0x68, 0x57, 0xcf,
+	0x9e, 0x0a, 0x5e, 0xb5, 0x66, 0xf1, 0x37, 0xf1, 0xec, 0xe0, 0x48, 0xb6, 0x0b, 0x78, 0x12, 0xe4,
+	0xd1, 0xb7, 0xd1, 0x86, 0x8a, 0x23, 0xd7, 0xfd, 0xb9, 0x17, 0xb6, 0x8b, 0x28, 0x5a, 0xb5, 0x22,
+	0xd2, 0xfc, 0xa2, 0x08, 0xa5, 0x0f, 0xe7, 0x22, 0x38, 0xe3, 0x79, 0x61, 0x18, 0x44, 0x6b, 0xd1,
+	0xb7, 0x71, 0x19, 0x4a, 0xae, 0xed, 0xe1, 0x62, 0x79, 0x5e, 0x4c, 0x11, 0xc6, 0xb3, 0x50, 0xb3,
+	0x0f, 0xf1, 0x9c, 0x23, 0xbc, 0x25, 0x6e, 0x93, 0xc3, 0x0b, 0x57, 0x99, 0xb1, 0xe7, 0x4c, 0x8c,
+	0xaf, 0x40, 0x75, 0xe2, 0x8f, 0xc6, 0xe9, 0xbd, 0x26, 0x3e, 0xef, 0x65, 0xdc, 0x84, 0x2a, 0xce,
+	0x18, 0xb9, 0xa8, 0xaf, 0x76, 0x09, 0x87, 0xea, 0x6b, 0x8d, 0xe8, 0xc2, 0xa4, 0x43, 0xab, 0x82,
+	0xa3, 0xac, 0xcc, 0x55, 0xa8, 0xca, 0x60, 0x3c, 0x3a, 0xc4, 0x6b, 0xb6, 0xcb, 0x2c, 0x78, 0x29,
+	0x12, 0x4c, 0xdd, 0xde, 0xaa, 0x48, 0x45, 0xd0, 0xf5, 0x02, 0x71, 0x22, 0x02, 0x29, 0xda, 0x15,
+	0xb5, 0xa5, 0x26, 0x8d, 0xdb, 0x50, 0x3f, 0xb4, 0xc7, 0x22, 0x1c, 0xcd, 0xec, 0xc0, 0x9e, 0xb6,
+	0xab, 0xd9, 0xc5, 0xee, 0xd1, 0xd0, 0x2e, 0x8d, 0x48, 0x0b, 0x0e, 0x63, 0xc2, 0x78, 0x07, 0x9a,
+	0x4c, 0xc9, 0xd1, 0xa1, 0xe3, 0xa2, 0x64, 0xbb, 0xc6, 0xf3, 0x8c, 0x78, 0x1e, 0x73, 0x87, 0x81,
+	0x10, 0x56, 0x43, 0x09, 0x2a, 0x8e, 0xf1, 0x55, 0x00, 0x71, 0x3a, 0xb3, 0xbd, 0xc9, 0xc8, 0x76,
+	0xdd, 0x36, 0xf0, 0x59, 0x6a, 0x8a, 0xd3, 0x75, 0x5d, 0xe3, 0x1a, 0x9d, 0xd3, 0x9e, 0x8c, 0x42,
+	0xd9, 0x6e, 0xe2, 0x58, 0xd1, 0x2a, 0x13, 0x39, 0x94, 0xa4, 0x19, 0xd7, 0xf1, 0x46, 0x44, 0xb5,
+	0x57, 0xb4, 0x66, 0xc8, 0xc7, 0x36, 0x1d, 0xcf, 0x42, 0x9e, 0x55, 0x71, 0xd5, 0x07, 0x19, 0xe4,
+	0xd0, 0x09, 0x50, 0x7f, 0x17, 0x51, 0xaa, 0x69, 0x29, 0xc2, 0xe8, 0xa0, 0xce, 0x71, 0x14, 0x85,
+	0x44, 0xbb, 0x85, 0x03, 0x05, 0x2b, 0xa6, 0x8d, 0x1b, 0xd0, 0x9c, 0x8a, 0xa9, 0x1f, 0x9c, 0x8d,
+	0x0e, 0xe6, 0x93, 0x23, 0x11, 0xb6, 0x9f, 0xe1, 0x9d, 0x1b, 0x8a, 0x79, 0x97, 0x79, 0xc6, 0x55,
+	0x28, 0x07, 0x78, 0x46, 0x7f, 0xda, 0x36, 0x78, 0x5d, 0x4d, 0x91, 0x4f, 0x48, 0x21, 0x26, 0xed,
+	0x4b, 0xbc, 0x28, 0x7f, 0x93, 0xf5, 0xc9, 0x30, 0x23, 0x74, 0x49, 0xd9, 0xbe, 0xcc, 0x57, 0xac,
+	0x12, 0x03, 0x7d, 0x55, 0x9a, 0x6f, 0x43, 0x8d, 0xdd, 0x9d, 0xcd, 0xf8, 0x12, 0x94, 0x4f, 0x88,
+	0x50, 0x51, 0x51, 0x5f, 0x7b, 0x26, 0xd2, 0x5f, 0x1c, 0x15, 0x96, 0x16, 0x30, 0xaf, 0x43, 0x75,
+	0x13, 0x7d, 0x2b, 0x0a, 0x25, 0xf2, 0x33, 0x9e, 0x84, 0x8e, 0x48, 0xdf, 0xe6, 0x67, 0x05, 0x28,
+	0x5b, 0x42, 0xce, 0xdd, 0xd0, 0x78, 0x05, 0x80, 0xbc, 0x68, 0x6a, 0x87, 0x81, 0x73, 0xaa, 0x57,
+	0xce, 0xfa, 0x51, 0x0d, 0xc7, 0xb7, 0x78, 0x18, 0xed, 0xdf, 0xe0, 0x1d, 0x22, 0xf1, 0x7c, 0xf6,
+	0x20, 0xf1, 0x59, 0xad, 0x3a, 0x8b, 0xe9, 0x59, 0xa8, 0x0e, 0x76, 0x60, 0x15, 0x44, 0xa8, 0x0e,
+	0x45, 0x19, 0xdf, 0x00, 0x85, 0x08, 0x52, 0x8c, 0xc3, 0xd1, 0x44, 0xc8, 0xc8, 0xc3, 0x9b, 0x31,
+	0x77, 0x03, 0x99, 0xc6, 0x5b, 0xa0, 0xbc, 0x22, 0xda, 0xb4, 0xc4, 0x9b, 0x1a, 0x19, 0xaf, 0x93,
+	0x6a, 0x57, 0x96, 0xd3, 0xbb, 0xbe, 0x01, 0x75, 0xba, 0x6b, 0x34, 0xab, 0xcc, 0xb3, 0x5a, 0xf1,
+	0xcd, 0xb4, 0x7a, 0x2c, 0x20, 0x21, 0x3d, 0x85, 0x54, 0x45, 0xd1, 0xa4, 0xbc, 0x9e, 0xbf, 0x9f,
+	0xde, 0x97, 0xd0, 0x59, 0x1d, 0x6f, 0x22, 0x4e, 0x47, 0x0f, 0xc5, 0x99, 0xe4, 0xd0, 0x28, 0x5a,
+	0x35, 0xe6, 0x3c, 0x40, 0x06, 0x05, 0xf2, 0x51, 0xe0, 0xcf, 0x67, 0x23, 0x0c, 0xf2, 0x1a, 0x7b,
+	0x45, 0x85, 0xe9, 0xfe, 0xc4, 0xfc, 0x79, 0x0e, 0x4a, 0x3b, 0xc1, 0x04, 0x1d, 0x7e, 0x19, 0x68,
+	0x20, 0x0f, 0x75, 0x33, 0x66, 0x4c, 0xc3, 0x43, 0xd1, 0x77, 0x02, 0x24, 0x85, 0x34, 0x90, 0xa0,
+	0xe4, 0xcc, 0x0e, 0x8f, 0x51, 0x8b, 0x6c, 0x69, 0xfa, 0x36, 0x9e, 0x43, 0x70, 0x39, 0x3a, 0x0a,
+	0xc4, 0x91, 0x1d, 0x0a, 0x46, 0x89, 0x9a, 0x95, 0x30, 0x68, 0x1d, 0x6f, 0xee, 0xa2, 0xe3, 0x95,
+	0x79, 0x44, 0x11, 0xe6, 0xef, 0xf2, 0x08, 0x8b, 0x7e, 0x10, 0x6e, 0x09, 0x29, 0xed, 0x23, 0xf2,
+	0xf9, 0x92, 0x4f, 0xc7, 0xd3, 0xde, 0xd1, 0x8c, 0x74, 0xc8, 0x67, 0xb6, 0xd4, 0xd8, 0x82, 0x1f,
+	0xe5, 0xcf, 0xf7, 0x23, 0xdc, 0x57, 0x41, 0x1a, 0xc1, 0x5d, 0xc9, 0x52, 0x04, 0xf9, 0x89, 0x7f,
+	0x78, 0x28, 0x85, 0xf2, 0x83, 0x92, 0xa5, 0xa9, 0xff, 0x41, 0x9c, 0xdf, 0x82, 0x12, 0x23, 0xaa,
+	0xc6, 0xc9, 0xd8, 0x77, 0xe8, 0x96, 0xeb, 0xf3, 0x40, 0xfa, 0x78, 0x0d, 0x16, 0xc8, 0xc4, 0x7e,
+	0xf9, 0x49, 0xb1, 0x5f, 0x79, 0x34, 0xf6, 0xcd, 0x1f, 0x03, 0xd0, 0xaa, 0xff, 0x49, 0x74, 0x3d,
+	0xf5, 0x75, 0xd2, 0xbe, 0x94, 0xcf, 0xfa, 0xd2, 0x31, 0xd4, 0x2d, 0xbc, 0xc9, 0xba, 0x8f, 0x5b,
+	0x9c, 0x86, 0xc6, 0x0a, 0xe4, 0x51, 0x26, 0xc7, 0x49, 0x05, 0xbf, 0x48, 0xf1, 0x2c, 0xa9, 0xa7,
+	0x29, 0x82, 0xdd, 0x6e, 0x32, 0x09, 0xd8, 0x1a, 0xe4, 0x76, 0xf8, 0x6d, 0x3c, 0x0f, 0x75, 0xe9,
+	0xd9, 0x33, 0x79, 0xec, 0x87, 0xa4, 0xf8, 0x22, 0x5f, 0x15, 0x22, 0xd6, 0x50, 0x9a, 0x7f, 0xcc,
+	0x41, 0x79, 0x4b, 0x4c, 0x0f, 0x50, 0x69, 0x8b, 0xbb, 0x3c, 0xfe, 0x7c, 0x4b, 0xb7, 0x42, 0xbb,
+	0xbb, 0x78, 0x2d, 0x34, 0x8f, 0x8a, 0x7f, 0x4d, 0x91, 0xdd, 0xed, 0xe9, 0x88, 0xd4, 0xcf, 0x76,
+	0xc3, 0x01, 0x7b, 0xba, 0x41, 0xf7, 0x7f, 0x9e, 0x42, 0x5b, 0x86, 0xa3, 0xf9, 0x6c, 0x42, 0x6e,
+	0x5d, 0x56, 0x67, 0x23, 0xd6, 0x1e, 0x73, 0x8c, 0x97, 0xe1, 0x99, 0xb1, 0x3b, 0x97, 0x94, 0x54,
+	0x1d, 0xef, 0xd0, 0x1f, 0xf9, 0x9e, 0x7b, 0xc6, 0xbe, 0x53, 0xb5, 0x2e, 0xea, 0x81, 0x3e, 0xf2,
+	0x77, 0x90, 0x6d, 0x7e, 0x96, 0x87, 0xd2, 0x7d, 0x56, 0xc3, 0x6d, 0xa8, 0x4c, 0xf9, 0x42, 0x11,
+	0xc2, 0x76, 0x22, 0x4b, 0xf1, 0xf8, 0xaa, 0xba, 0xad, 0xec, 0x79, 0x61, 0x70, 0x66, 0x45, 0xa2,
+	0x34, 0x2b, 0xb4, 0x0f, 0x5c, 0xc4, 0x20, 0xed, 0xf5, 0x0b, 0xb3, 0x86, 0x6a, 0x50, 0xcf, 0xd2,
+	0xa2, 0x9d, 0x0f, 0xa0, 0x91, 0x5e, 0x8e, 0xea, 0x19, 0xc4, 0x0d, 0xd6, 0x61, 0xd1, 0xa2, 0x4f,
+	0xe3, 0xeb, 0x50, 0x62, 0x10, 0x65, 0x0d, 0xd6, 0xd7, 0x56, 0xa2, 0x55, 0xd5, 0x34, 0x4b, 0x0d,
+	0xde, 0xc9, 0xbf, 0x9b, 0xa3, 0xb5, 0xd2, 0x9b, 0xa4, 0xd7, 0xaa, 0x9d, 0xbf, 0x96, 0x9a, 0x96,
+	0x5a, 0xcb, 0xfc, 0x55, 0x1e, 0x1a, 0x3f, 0x10, 0x81, 0xbf, 0x1b, 0xf8, 0x33, 0x5f, 0x62, 0x59,
+	0x95, 0xd8, 0xb6, 0xc9, 0xb6, 0x7d, 0x11, 0xca, 0xea, 0xe6, 0x8f, 0x39, 0x97, 0x1e, 0x25, 0x39,
+	0x75, 0x57, 0x36, 0xf5, 0xa3, 0x7b, 0xea, 0x51, 0xe3, 0x3a, 0xc0, 0xd4, 0x3e, 0xdd, 0x14, 0xb6,
+	0x14, 0xfd, 0x49, 0xe4, 0x66, 0x09, 0x87, 0x02, 0x12, 0xa9, 0xe1, 0xa9, 0x37, 0x94, 0xec, 0x05,
+	0x45, 0x2b, 0xa6, 0x09, 0xdc, 0xf0, 0x9b, 0xfc, 0x1d, 0xa7, 0x2a, 0x2f, 0x48, 0x18, 0xc6, 0xd7,
+	0xa0, 0x10, 0x9e, 0x7a, 0x1c, 0xa4, 0xf5, 0xb5, 0x8b, 0x1c, 0x49, 0x38, 0x4d, 0x47, 0x86, 0x45,
+	0x63, 0xc6, 0x6b, 0x50, 0x0b, 0x9d, 0x29, 0xa5, 0xb3, 0xe0, 0xa1, 0xae, 0x66, 0xe2, 0x0c, 0x31,
+	0xc4, 0x81, 0x2d, 0xe4, 0x5b, 0xd5, 0x50, 0x7f, 0x99, 0xff, 0x2c, 0xc0, 0x45, 0x6d, 0xb5, 0x63,
+	0x67, 0x36, 0x08, 0xc9, 0xd5, 0xb0, 0x58, 0x62, 0xf4, 0x12, 0x81, 0x36, 0x5e, 0x44, 0x1a, 0xdf,
+	0x86, 0x32, 0x7b, 0x7d, 0xe4, 0x17, 0x37, 0xb2, 0x9a, 0x8a, 0x97, 0x50, 0x7e, 0xa2, 0x1d, 0x44,
+	0x4f, 0x31, 0xde, 0x85, 0xd2, 0xa7, 0x68, 0x06, 0x85, 0xf0, 0xf5, 0x35, 0xf3, 0x71, 0x73, 0xc9,
+	0x56, 0x7a, 0xaa, 0x9a, 0xf0, 0x7f, 0x54, 0xe8, 0x2d, 0xc2, 0xe1, 0xa9, 0x7f, 0x82, 0x15, 0x4c,
+	0x85, 0x4f, 0xb5, 0x68, 0xfb, 0x68, 0xd8, 0xf8, 0x26, 0x40, 0xac, 0x57, 0xca, 0x85, 0x85, 0xa5,
+	0x8a, 0xad, 0x45, 0x8a, 0x95, 0x9d, 0xf7, 0xa1, 0x9e, 0xd2, 0x42, 0xda, 0x83, 0x9b, 0xca, 0x83,
+	0x6f, 0x64, 0x3d, 0xb8, 0x99, 0x89, 0xb1, 0x74, 0x30, 0xbc, 0x0f, 0x90, 0xe8, 0xe4, 0xbf, 0x09,
+	0x2b, 0xf3, 0x67, 0x39, 0xb8, 0x88, 0xde, 0xe2, 0x09, 0x2e, 0x8f, 0x95, 0xb5, 0x13, 0xef, 0xcf,
+	0x9d, 0xeb, 0xfd, 0xaf, 0x41, 0x49, 0xd2, 0x04, 0xbd, 0xcb, 0xb5, 0xc7, 0x98, 0xcf, 0x52, 0x52,
+	0x04, 0x68, 0xa8, 0xe6, 0xd1, 0x4c, 0x78, 0x13, 0x7c, 0xa7, 0x70, 0xc4, 0x28, 0xa3, 0xed, 0x2a,
+	0x8e, 0xf9, 0x1b, 0x04, 0x5b, 0x15, 0x38, 0x19, 0x70, 0xcd, 0x65, 0xc1, 0x15, 0xcd, 0x37, 0x0b,
+	0xc4, 0xc4, 0x19, 0x47, 0x3b, 0x63, 0xb2, 0x8f, 0x19, 0x5c, 0xec, 0xfa, 0xc1, 0x58, 0xf0, 0xf2,
+	0x55, 0x4b, 0x11, 0x54, 0x7f, 0x72, 0x72, 0x65, 0x88, 0x54, 0xf8, 0x5b, 0x25, 0x06, 0x61, 0x23,
+	0x4d, 0x91, 0x33, 0xac, 0xa9, 0x38, 0x88, 0x0a, 0x96, 0x22, 0xb8, 0xbc, 0x65, 0x43, 0x73, 0xc8,
+	0x54, 0x2d, 0x4d, 0x99, 0x5f, 0x22, 0x76, 0x6c, 0x38, 0x01, 0xea, 0x4b, 0x4c, 0x7a, 0x98, 0x0c,
+	0x49, 0x50, 0x78, 0xa1, 0x13, 0x9e, 0xe9, 0xdc, 0xa0, 0xa9, 0xb8, 0xcc, 0xc9, 0x67, 0xdf, 0x46,
+	0xca, 0x2e, 0x05, 0x7e, 0xd2, 0x29, 0xc2, 0x78, 0x1b, 0x40, 0x15, 0x9c, 0xfc, 0xac, 0x2b, 0x9e,
+	0xff, 0xac, 0xab, 0xb1, 0x28, 0x7d, 0x92, 0x92, 0xd4, 0x3c, 0x47, 0xe5, 0x8e, 0x32, 0xbf, 0xf9,
+	0xe6, 0xe4, 0xff, 0x5c, 0x3b, 0x1d, 0x08, 0x37, 0xaa, 0x79, 0x98, 0x88, 0xab, 0xe4, 0x8a, 0x3a,
+	0x12, 0x7d, 0x63, 0x3e, 0xce, 0xfb, 0x33, 0xbe, 0x63, 0x6a, 0xd3, 0xf4, 0x05, 0x57, 0x77, 0x66,
+	0x16, 0x8a, 0x18, 0x26, 0x94, 0xd5, 0xbb, 0x05, 0x2b, 0x3b, 0x72, 0x75, 0x60, 0xb0, 0xe1, 0xc2,
+	0xd4, 0xd2, 0x23, 0x6c, 0x1b, 0x5f, 0x3a, 0xe4, 0x4a, 0x92, 0x9f, 0x32, 0x0d, 0x2b, 0x61, 0x98,
+	0x57, 0x21, 0xbf, 0x33, 0x33, 0x2a, 0x50, 0x18, 0xf4, 0x86, 0xad, 0x0b, 0xf4, 0xb1, 0xd1, 0xdb,
+	0x6c, 0xe5, 0xcc, 0x5f, 0xe4, 0xa1, 0xb6, 0x35, 0x47, 0x1f, 0x21, 0xa9, 0xf3, 0x4c, 0x8f, 0x43,
+	0xe8, 0x4a, 0x01, 0xe7, 0xea, 0xbc, 0xc2, 0x21, 0xa6, 0x31, 0xa8, 0x5f, 0x86, 0x92, 0xc0, 0xc3,
+	0x46, 0x50, 0x72, 0x79, 0xd9, 0x4d, 0x2c, 0x25, 0x62, 0xbc, 0x0a, 0x65, 0x39, 0x3e, 0x16, 0x53,
+	0x9b, 0x8b, 0xc8, 0x94, 0xf0, 0x80, 0xb9, 0x2a, 0xbd, 0x5a, 0x5a, 0x86, 0x1f, 0xa7, 0x98, 0x27,
+	0xf8, 0x75, 0x56, 0xd2, 0x8f, 0x53, 0xa4, 0xe9, 0x6d, 0xb6, 0x06, 0x57, 0x9c, 0x23, 0xcf, 0x0f,
+	0xd0, 0x02, 0x5c, 0x14, 0x8f, 0x7d, 0xef, 0xd0, 0x75, 0xc6, 0x21, 0x6b, 0xbd, 0x6a, 0x5d, 0x52,
+	0x83, 0x7d, 0x1a, 0x5b, 0xd7, 0x43, 0x54, 0xa5, 0x91, 0x99, 0xa5, 0x46, 0x97, 0xb8, 0x4a, 0x23,
+	0x8b, 0xea, 0x9d, 0x95, 0x80, 0x79, 0x13, 0x6a, 0x58, 0x54, 0xf3, 0x73, 0x43, 0x22, 0xa0, 0xe5,
+	0x1f, 0x9e, 0xe8, 0x8c, 0x0d, 0xd1, 0x9c, 0x07, 0xfb, 0x16, 0x72, 0xcd, 0xcf, 0xf3, 0x50, 0x8d,
+	0x53, 0x19, 0xd6, 0x6f, 0x13, 0x81, 0xf1, 0x40, 0xd1, 0x30, 0x49, 0x74, 0xd8, 0x48, 0x98, 0x7d,
+	0x82, 0xae, 0xda, 0x34, 0x52, 0xb8, 0x8e, 0xde, 0xf8, 0x7d, 0x13, 0x5b, 0xc2, 0x4a, 0x64, 0x8c,
+	0xd7, 0xa1, 0x8e, 0xa9, 0x84, 0x2e, 0x48, 0x79, 0x45, 0x67, 0xbb, 0x47, 0xd2, 0x0d, 0x84, 0xf1,
+	0xb7, 0x3e, 0x70, 0x71, 0xd9, 0x81, 0x13, 0xe0, 0x28, 0x3d, 0x15, 0x70, 0xdc, 0x04, 0xac, 0x67,
+	0x84, 0xed, 0x8d, 0x92, 0xb8, 0x57, 0x6e, 0xbd, 0xc2, 0xec, 0xdd, 0x38, 0xf8, 0x35, 0x10, 0x56,
+	0xe2, 0x9a, 0xc0, 0xc4, 0xf4, 0xf8, 0x60, 0x7f, 0x70, 0xae, 0xf6, 0x7e, 0x08, 0xf9, 0x07, 0xfb,
+	0x69, 0x0c, 0x6d, 0x28, 0x0c, 0xd5, 0xcd, 0x97, 0x7c, 0xd2, 0x7c, 0xc1, 0xa4, 0x32, 0x97, 0x22,
+	0xd8, 0x12, 0xa1, 0xad, 0x03, 0x38, 0xa6, 0x29, 0x43, 0x52, 0xf7, 0x00, 0x95, 0xa5, 0xb3, 0x51,
+	0x44, 0x9a, 0xbf, 0x2e, 0x42, 0x45, 0x07, 0x31, 0xad, 0x39, 0x8f, 0x8b, 0x48, 0xfa, 0x4c, 0x10,
+	0x21, 0x9f, 0x46, 0x84, 0x74, 0x9b, 0xa7, 0xf0, 0x74, 0x6d, 0x1e, 0xe3, 0xbb, 0xd0, 0x98, 0xa9,
+	0xb1, 0x34, 0x8e, 0x3c, 0xbb, 0x38, 0x4f, 0xff, 0xcf, 0x73, 0xeb, 0xb3, 0x84, 0x20, 0x3f, 0xe7,
+	0xa7, 0x64, 0x68, 0x1f, 0xb1, 0x5d, 0x1a, 0x58, 0x8a, 0x23, 0x3d, 0xb4, 0x8f, 0x1e, 0x83, 0x26,
+	0x4f, 0x03, 0x08, 0x2b, 0x8c, 0x2e, 0x0d, 0x55, 0x58, 0x21, 0x88, 0xa4, 0x23, 0xb8, 0x99, 0x8d,
+	0x60, 0xc4, 0xe8, 0xb1, 0x3f, 0x9d, 0x3a, 0x3c, 0xb6, 0xa2, 0x72, 0xb6, 0x62, 0x0c, 0x17, 0x80,
+	0xa5, 0xb2, 0x08, 0x2c, 0x3f, 0xcd, 0x41, 0x45, 0xeb, 0xc3, 0xa8, 0x43, 0x65, 0xa3, 0x77, 0xaf,
+	0xbb, 0xb7, 0x49, 0x10, 0x03, 0x50, 0xbe, 0xdb, 0xdf, 0xee, 0x5a, 0x1f, 0xb7, 0x72, 0x04, 0x37,
+	0xfd, 0xed, 0x61, 0x2b, 0x6f, 0xd4, 0xa0, 0x74, 0x6f, 0x73, 0xa7, 0x3b, 0x6c, 0x15, 0x8c, 0x2a,
+	0x14, 0xef, 0xee, 0xec, 0x6c, 0xb6, 0x8a, 0x46, 0x03, 0xaa, 0x1b, 0xdd, 0x61, 0x6f, 0xd8, 0xdf,
+	0xea, 0xb5, 0x4a, 0x24, 0x7b, 0xbf, 0xb7, 0xd3, 0x2a, 0xd3, 0xc7, 0x5e, 0x7f, 0xa3, 0x55, 0xa1,
+	0xf1, 0xdd, 0xee, 0x60, 0xf0, 0xd1, 0x8e, 0xb5, 0xd1, 0xaa, 0xd2, 0xba, 0x83, 0xa1, 0xd5, 0xdf,
+	0xbe, 0xdf, 0xaa, 0xd1, 0xf7, 0xbe, 0x5a, 0x0f, 0x4c, 0x7c, 0x8e, 0xa7, 0xf4, 0x4b, 0xb3, 0xad,
+	0xde, 0x3d, 0x3c, 0x07, 0x6e, 0xb9, 0xdf, 0xdd, 0xdc, 0xeb, 0xe1, 0x31, 0x56, 0x00, 0xf8, 0x73,
+	0xb4, 0xd9, 0xc5, 0xe9, 0x79, 0xf3, 0x27, 0xb9, 0x78, 0x0e, 0x77, 0x32, 0x5e, 0x81, 0xaa, 0xb6,
+	0x4a, 0x54, 0xa0, 0x5f, 0x5c, 0x30, 0xa1, 0x15, 0x0b, 0x90, 0x47, 0x22, 0x48, 0x8d, 0x1f, 0xca,
+	0xf9, 0x54, 0x3b, 0x50, 0x4c, 0xab, 0x86, 0x04, 0xa9, 0x4f, 0x67, 0x5a, 0x4d, 0xc5, 0x5d, 0xc7,
+	0x22, 0xcb, 0xab, 0xae, 0xe3, 0x6d, 0x80, 0xa4, 0xaf, 0xb5, 0xa4, 0xb4, 0x46, 0x07, 0xb0, 0x5d,
+	0xc7, 0x96, 0x3a, 0x99, 0x29, 0xc2, 0xb4, 0xa0, 0x9e, 0xea, 0x86, 0x91, 0x6d, 0x11, 0x23, 0x55,
+	0x67, 0x20, 0xa7, 0x80, 0x12, 0x69, 0xee, 0x0b, 0x20, 0xe8, 0xa9, 0x66, 0x5a, 0x7e, 0x49, 0x5b,
+	0x83, 0xa7, 0x5b, 0x4a, 0xc0, 0x44, 0x6c, 0x56, 0xbd, 0x8e, 0x94, 0x7b, 0xe5, 0x1e, 0xe7, 0x5e,
+	0xe6, 0x7b, 0xfa, 0xdc, 0xdc, 0x19, 0x41, 0x54, 0xab, 0xeb, 0x16, 0x1c, 0x37, 0x38, 0x72, 0xd9,
+	0xf2, 0x4d, 0x09, 0xea, 0x9e, 0x1d, 0x4f, 0x30, 0x37, 0xa0, 0x7a, 0x6e, 0x5b, 0x54, 0x2b, 0x22,
+	0x9f, 0x28, 0x62, 0x49, 0xa3, 0xd4, 0x0c, 0xf0, 0x10, 0x71, 0x73, 0x4f, 0x7b, 0xbc, 0x5a, 0x85,
+	0x3c, 0x7e, 0x95, 0x4c, 0xe4, 0xb8, 0x93, 0x40, 0x78, 0x8f, 0xdc, 0x3e, 0x69, 0x09, 0xc6, 0x32,
+	0x58, 0xba, 0x15, 0xb9, 0x87, 0x59, 0xc8, 0x16, 0xea, 0x71, 0x03, 0x93, 0x47, 0xcd, 0x03, 0x68,
+	0xaa, 0x64, 0x65, 0x89, 0x4f, 0xe6, 0xd4, 0x3f, 0x3a, 0x27, 0x6b, 0x62, 0xad, 0x1c, 0x03, 0x67,
+	0xd4, 0x95, 0x4d, 0x71, 0xc8, 0x51, 0x0e, 0x1d, 0xe1, 0x4e, 0xa2, 0x5b, 0x69, 0xca, 0x7c, 0x07,
+	0x1a, 0xd1, 0x1e, 0xfc, 0xcc, 0xbf, 0x19, 0xa7, 0xcd, 0xc8, 0x2f, 0xc9, 0x20, 0x4a, 0x64, 0xdb,
+	0x9f, 0xc4, 0x19, 0xd3, 0xfc, 0x65, 0x21, 0x9a, 0xa9, 0x5f, 0xaa, 0x99, 0x92, 0x2d, 0xb7, 0x58,
+	0xb2, 0x65, 0xcb, 0x9f, 0xfc, 0x53, 0x97, 0x3f, 0xdf, 0x81, 0xda, 0x84, 0xb3, 0xbb, 0x73, 0x12,
+	0xa1, 0xe4, 0xf5, 0x65, 0x99, 0x5c, 0xd7, 0x00, 0x28, 0x65, 0x25, 0x13, 0xe8, 0x4c, 0xa1, 0xff,
+	0x50, 0x78, 0xce, 0xa7, 0xfc, 0x24, 0xa7, 0x8b, 0x27, 0x8c, 0xa4, 0x77, 0xa3, 0x32, 0xbe, 0xee,
+	0xdd, 0x44, 0xad, 0xb3, 0x72, 0xaa, 0x75, 0x86, 0xda, 0xc3, 0x8a, 0x5e, 0x04, 0x61, 0x54, 0x27,
+	0x2a, 0x2a, 0xae, 0xb5, 0x6a, 0x5a, 0x96, 0x6a, 0x2d, 0x84, 0x75, 0xdb, 0xb3, 0xdd, 0x33, 0xda,
+	0x12, 0xd8, 0xbe, 0x57, 0xa3, 0x03, 0x77, 0x35, 0x9f, 0xea, 0x04, 0x07, 0x43, 0x3c, 0x92, 0x33,
+	0xbf, 0x05, 0xb5, 0xf8, 0xfc, 0x84, 0x57, 0xdb, 0x3b, 0xdb, 0x3d, 0x85, 0x28, 0xfd, 0xed, 0x8d,
+	0xde, 0xf7, 0x11, 0x51, 0x10, 0xf1, 0xac, 0xde, 0x7e, 0xcf, 0x1a, 0xf4, 0x10, 0xdc, 0x10, 0x8d,
+	0xb0, 0xa8, 0xea, 0x0d, 0x7b, 0xad, 0xc2, 0x07, 0xc5, 0x6a, 0xa5, 0x85, 0x85, 0xae, 0x38, 0x9d,
+	0x61, 0xe5, 0xe1, 0x84, 0xe6, 0xc7, 0x50, 0xdd, 0xb2, 0x67, 0x8f, 0xbc, 0x19, 0x92, 0x7c, 0x37,
+	0xd7, 0xad, 0x0c, 0x9d, 0x9b, 0x5e, 0x82, 0x8a, 0x46, 0x9a, 0x38, 0xe1, 0x2f, 0x20, 0x51, 0x34,
+	0x6e, 0xfe, 0x36, 0x07, 0x97, 0xb7, 0xb0, 0x3c, 0x8e, 0x73, 0xf1, 0xae, 0x7d, 0xe6, 0xfa, 0xf6,
+	0xe4, 0x09, 0xa6, 0x7f, 0x11, 0x2e, 0x4a, 0x7f, 0x8e, 0x15, 0xfa, 0x68, 0xa1, 0x95, 0xd2, 0x54,
+	0xec, 0xfb, 0xda, 0x85, 0x4d, 0x2a, 0x6a, 0x64, 0x98, 0x48, 0x15, 0x58, 0xaa, 0x4e, 0xcc, 0x48,
+	0x26, 0x2e, 0x2a, 0x8a, 0x4f, 0x53, 0x54, 0x98, 0x5f, 0xe4, 0xa0, 0xd9, 0x3b, 0x9d, 0xf9, 0x41,
+	0x18, 0x1d, 0xf5, 0x0a, 0x55, 0xfc, 0x9f, 0x44, 0x01, 0x54, 0xb4, 0x4a, 0x48, 0xf5, 0xcf, 0xed,
+	0xf3, 0xdc, 0xc6, 0x88, 0xc0, 0xc5, 0xe6, 0x52, 0xbb, 0xdf, 0x73, 0xd1, 0x9e, 0x99, 0x85, 0x57,
+	0x07, 0x2c, 0x63, 0x69, 0xd9, 0x74, 0xa7, 0xaf, 0x98, 0xee, 0xf4, 0x99, 0x77, 0x30, 0xab, 0x28,
+	0x91, 0xc4, 0xce, 0x68, 0xdc, 0xc1, 0xde, 0xfa, 0x7a, 0x6f, 0x30, 0x40, 0x4b, 0x37, 0xd1, 0x17,
+	0xf6, 0x76, 0x37, 0xfb, 0xeb, 0x98, 0xa9, 0x94, 0xad, 0xef, 0x75, 0xfb, 0x9b, 0xbd, 0x8d, 0x56,
+	0xc1, 0xfc, 0x3d, 0xa6, 0x91, 0x9d, 0xc0, 0xc6, 0x82, 0x68, 0x43, 0xb8, 0x58, 0x8f, 0xdc, 0xa1,
+	0x17, 0x3b, 0xe1, 0x7d, 0x04, 0x9f, 0x2f, 0x24, 0x0d, 0xcd, 0x58, 0x6a, 0x75, 0x5d, 0x89, 0xe8,
+	0xb6, 0x8d, 0x9e, 0x40, 0x2e, 0x6d, 0x1f, 0xe0, 0xf9, 0x15, 0x58, 0xe0, 0xf9, 0x14, 0xf5, 0xc4,
+	0x07, 0x5c, 0xe7, 0x0e, 0x34, 0xd2, 0x2b, 0x2e, 0x79, 0x98, 0x66, 0xca, 0x9d, 0x62, 0xfa, 0x21,
+	0xfa, 0x3c, 0x34, 0xe9, 0x79, 0x8e, 0x8f, 0x65, 0x54, 0xd3, 0x74, 0xc6, 0xa5, 0x83, 0x3e, 0x7c,
+	0xd1, 0xc2, 0x2f, 0xf3, 0x45, 0x68, 0xec, 0x0a, 0x7c, 0x7d, 0x0a, 0x39, 0xc3, 0x9c, 0xcf, 0xef,
+	0x2e, 0xad, 0x7c, 0x95, 0x6c, 0x34, 0x65, 0x5e, 0x83, 0xc2, 0xf6, 0x7c, 0x9a, 0xfe, 0xed, 0xac,
+	0xc8, 0xe5, 0x9b, 0x79, 0x0f, 0x51, 0x49, 0x77, 0xf6, 0xb8, 0x64, 0xa3, 0x82, 0xc3, 0x75, 0xf0,
+	0xb5, 0x36, 0x0a, 0xa5, 0x96, 0xab, 0x2a, 0xc6, 0x50, 0x9e, 0xd7, 0x7d, 0xec, 0x02, 0x24, 0xc5,
+	0x3a, 0xad, 0x42, 0xb8, 0x35, 0x4a, 0x25, 0x8f, 0x2a, 0x31, 0xb6, 0x29, 0x81, 0x24, 0xd0, 0x9a,
+	0xcf, 0x40, 0xeb, 0x9f, 0x72, 0xb0, 0x92, 0x8d, 0xf8, 0xd4, 0x2f, 0x18, 0xc9, 0xdb, 0x0c, 0x83,
+	0x47, 0x86, 0xfe, 0xec, 0x47, 0x7e, 0x10, 0xaf, 0x90, 0x30, 0x30, 0x3c, 0x5b, 0xe3, 0x39, 0x92,
+	0xd3, 0x51, 0x22, 0x54, 0xd0, 0xed, 0x3f, 0xe6, 0x0f, 0x62, 0x51, 0x7c, 0x14, 0xc8, 0x33, 0xcf,
+	0xf7, 0xce, 0xa6, 0xfc, 0xf3, 0x94, 0x8a, 0x91, 0x9a, 0xd5, 0x88, 0x98, 0x98, 0x89, 0x04, 0x15,
+	0x13, 0x11, 0xcd, 0x3f, 0x3f, 0xe0, 0x45, 0x22, 0x9a, 0x7c, 0xd6, 0xf3, 0x71, 0x1f, 0x31, 0xd5,
+	0xe0, 0x57, 0xf6, 0xfc, 0x01, 0x52, 0xe6, 0xe7, 0xe8, 0x77, 0xeb, 0xc7, 0x78, 0x58, 0xd1, 0x3b,
+	0x41, 0xcd, 0x61, 0xa6, 0x2f, 0xd2, 0x23, 0x4b, 0x77, 0x0e, 0x96, 0x3f, 0xc3, 0x58, 0xe2, 0xbc,
+	0xc7, 0x5c, 0xa6, 0x14, 0x2c, 0x2c, 0x94, 0x82, 0x18, 0xa6, 0xae, 0x7f, 0x44, 0x76, 0x51, 0xd1,
+	0x53, 0x42, 0x0a, 0x63, 0x11, 0xed, 0x2d, 0xc5, 0x27, 0xba, 0xd9, 0x43, 0x9f, 0xe6, 0x08, 0x56,
+	0xd4, 0xc9, 0x64, 0x2a, 0x49, 0x4a, 0xc7, 0x43, 0xb4, 0x89, 0x0d, 0x5e, 0x61, 0x3a, 0xb3, 0x6a,
+	0x3e, 0xbd, 0x6a, 0xfc, 0xb3, 0x25, 0xad, 0xad, 0x4f, 0xc2, 0x8c, 0x01, 0x6e, 0x70, 0x03, 0x8a,
+	0xe4, 0xaf, 0x24, 0x34, 0xf7, 0x9c, 0x53, 0x74, 0x01, 0xcf, 0xe7, 0x75, 0x0b, 0xf8, 0x30, 0x40,
+	0xc6, 0x36, 0xd2, 0x78, 0x0a, 0x48, 0x1a, 0xf0, 0xff, 0xc6, 0xcf, 0x5b, 0xc9, 0xcf, 0x16, 0xfa,
+	0x40, 0x4c, 0x44, 0x28, 0xad, 0x8e, 0x42, 0x9f, 0x98, 0xa6, 0xab, 0x51, 0xb3, 0xe9, 0xdc, 0x93,
+	0xe8, 0x80, 0x52, 0xab, 0xe1, 0xd7, 0xda, 0x1f, 0x72, 0x50, 0xa4, 0xa6, 0x17, 0x95, 0x1c, 0xbd,
+	0xf1, 0xb1, 0x6f, 0xa8, 0x46, 0xbc, 0x46, 0xad, 0x4e, 0x86, 0x32, 0x2f, 0x60, 0x61, 0xca, 0x4d,
+	0xf7, 0xe8, 0xf7, 0x92, 0xf3, 0x85, 0xd7, 0xa0, 0xfe, 0x81, 0xef, 0x78, 0xeb, 0xaa, 0x0d, 0x6d,
+	0xc4, 0xbf, 0x9e, 0xa6, 0xda, 0xf6, 0x8f, 0xcc, 0x79, 0x0b, 0xca, 0x7d, 0x49, 0x21, 0xbe, 0x5c,
+	0x3c, 0xf6, 0xa5, 0x34, 0x0a, 0x98, 0x17, 0xd6, 0xfe, 0x52, 0x80, 0x22, 0x35, 0xc3, 0xa8, 0x47,
+	0xad, 0x3b, 0x59, 0xc6, 0x42, 0xc7, 0xaa, 0x13, 0x27, 0x83, 0x85, 0x56, 0x17, 0xee, 0xfa, 0x36,
+	0x94, 0x75, 0x24, 0x67, 0xdb, 0x6d, 0x9d, 0xc7, 0x25, 0x10, 0xf3, 0xc2, 0xad, 0xdc, 0xeb, 0x39,
+	0x2c, 0x36, 0xcb, 0x0a, 0x49, 0x17, 0x34, 0x71, 0x69, 0x09, 0xce, 0x9a, 0x17, 0x78, 0x42, 0x7d,
+	0x70, 0xec, 0xcf, 0xdd, 0xc9, 0x40, 0x04, 0x98, 0xca, 0x17, 0x5a, 0xc5, 0x9d, 0x05, 0x1a, 0x4f,
+	0xf6, 0x1a, 0x40, 0x57, 0x4a, 0xe7, 0xc8, 0xdb, 0xc3, 0x12, 0xdd, 0xa8, 0x47, 0xe3, 0x08, 0x6e,
+	0x9d, 0x16, 0x6f, 0xa9, 0x46, 0xe9, 0x41, 0x2f, 0x95, 0x78, 0x0a, 0x3d, 0x9f, 0x28, 0xfe, 0x26,
+	0x34, 0x15, 0x56, 0xef, 0x04, 0x5d, 0x82, 0x77, 0x63, 0xf1, 0x35, 0xdf, 0x59, 0x64, 0xe0, 0xa4,
+	0x3b, 0xe8, 0x6b, 0xc1, 0x99, 0x92, 0xbf, 0x12, 0x1f, 0x38, 0x0d, 0xdb, 0x9d, 0xe5, 0x6c, 0x9c,
+	0xfb, 0x32, 0xd4, 0x63, 0xba, 0x1b, 0x1a, 0x8d, 0x74, 0xa7, 0xb4, 0x93, 0x3e, 0x2e, 0xda, 0xf4,
+	0x1f, 0x05, 0x28, 0x7f, 0xe4, 0x07, 0x0f, 0xd1, 0x17, 0x56, 0xa1, 0xcc, 0x1d, 0x09, 0x61, 0x3c,
+	0xda, 0xa1, 0x58, 0x76, 0xc4, 0x57, 0xa1, 0xc6, 0x0a, 0xa6, 0x80, 0x4a, 0x4c, 0xca, 0x7f, 0xae,
+	0x90, 0xe8, 0x58, 0x55, 0xb4, 0x28, 0xfd, 0x3d, 0xb8, 0x1a, 0x97, 0x2c, 0x5d, 0x6f, 0xa2, 0xca,
+	0xc6, 0x0d, 0x1b, 0xb3, 0x43, 0xd2, 0x14, 0x4a, 0xe5, 0x8c, 0xe4, 0x9c, 0x0f, 0xf6, 0x07, 0x6c,
+	0xd5, 0x37, 0xa0, 0x48, 0xe1, 0x9d, 0xb8, 0x6c, 0xea, 0x37, 0xc5, 0x4e, 0xe6, 0x27, 0xb8, 0x78,
+	0xcf, 0x77, 0x30, 0xcd, 0xab, 0xd6, 0xd2, 0x95, 0x6c, 0xb9, 0xaa, 0x61, 0xaa, 0x73, 0x79, 0x91,
+	0xad, 0x27, 0xde, 0xc4, 0xfa, 0xcd, 0xf1, 0x54, 0x13, 0x3b, 0xeb, 0x74, 0x59, 0xf5, 0x19, 0xef,
+	0x42, 0x59, 0x55, 0x20, 0xc9, 0x0e, 0x99, 0x8a, 0xa4, 0xb3, 0x9c, 0x8d, 0x33, 0xdf, 0x80, 0x96,
+	0x25, 0xc6, 0xc2, 0x49, 0x55, 0x72, 0x46, 0xfa, 0xce, 0x8b, 0x41, 0x7b, 0x2b, 0x67, 0xbc, 0x07,
+	0xcd, 0x4c, 0xe5, 0x67, 0xc4, 0x55, 0xd0, 0xb2, 0x82, 0x70, 0x71, 0x81, 0xb5, 0x33, 0x7c, 0xc9,
+	0xcc, 0x0f, 0xe4, 0x38, 0x70, 0x66, 0xaa, 0xd5, 0x44, 0x06, 0x54, 0x8c, 0x83, 0x28, 0xb6, 0x22,
+	0xc5, 0x34, 0x35, 0x15, 0xc5, 0x3e, 0xea, 0x1f, 0xeb, 0x1c, 0x0d, 0xf2, 0x46, 0x5c, 0x4b, 0x67,
+	0x51, 0x3f, 0x89, 0xc8, 0x54, 0x9e, 0xa2, 0xb9, 0x77, 0x5b, 0x7f, 0xfe, 0xdb, 0xf5, 0xdc, 0x97,
+	0xf8, 0xef, 0xaf, 0xf8, 0xef, 0xf3, 0xbf, 0x5f, 0xbf, 0x70, 0x50, 0xe6, 0x3f, 0xd0, 0x79, 0xf3,
+	0x5f, 0x96, 0x13, 0xe6, 0x7b, 0xc5, 0x23, 0x00, 0x00,
 }
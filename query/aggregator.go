@@ -9,7 +9,10 @@ package query
 
 import (
 	"bytes"
+	"fmt"
 	"math"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/intern"
@@ -21,6 +24,43 @@ type aggregator struct {
 	name   string
 	result types.Val
 	count  int // used when we need avergae.
+	// Values collected by the aggregators which need all of them to compute their result.
+	values     []types.Val
+	percentile float64
+}
+
+func newAggregator(fn *Function) aggregator {
+	ag := aggregator{name: fn.Name}
+	switch fn.Name {
+	case "median":
+		ag.percentile = 0.5
+	case "percentile":
+		if len(fn.Args) > 0 {
+			// The parser checked that it's a number between 0 and 1.
+			ag.percentile, _ = strconv.ParseFloat(fn.Args[0].Value, 64)
+		}
+	}
+	return ag
+}
+
+// aggregatorFieldName returns the name of the field holding the result of applying the
+// aggregator fn to arg, as it's written in the query.
+func aggregatorFieldName(fn *Function, arg string) string {
+	switch {
+	case fn.Name == "count_distinct":
+		return fmt.Sprintf("count(distinct %s)", arg)
+	case fn.Name == "percentile" && len(fn.Args) > 0:
+		return fmt.Sprintf("percentile(%s, %s)", arg, fn.Args[0].Value)
+	}
+	return fmt.Sprintf("%s(%s)", fn.Name, arg)
+}
+
+func collectsValues(f string) bool {
+	switch f {
+	case "count_distinct", "median", "percentile", "stddev", "variance":
+		return true
+	}
+	return false
 }
 
 func isUnary(f string) bool {
@@ -252,6 +292,11 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 }
 
 func (ag *aggregator) Apply(val types.Val) {
+	if collectsValues(ag.name) {
+		ag.values = append(ag.values, val)
+		ag.count++
+		return
+	}
 	if ag.result.Value == nil {
 		ag.result = val
 		ag.count++
@@ -294,6 +339,7 @@ func (ag *aggregator) Apply(val types.Val) {
 
 func (ag *aggregator) ValueMarshalled() (*intern.TaskValue, error) {
 	data := types.ValueForType(types.BinaryID)
+	ag.fromValues()
 	ag.divideByCount()
 	res := &intern.TaskValue{ValType: ag.result.Tid.Enum(), Val: x.Nilbyte}
 	if ag.result.Value == nil {
@@ -323,7 +369,104 @@ func (ag *aggregator) divideByCount() {
 	ag.result.Value = v / float64(ag.count)
 }
 
+// fromValues computes the result of the aggregators which collect the values.
+func (ag *aggregator) fromValues() {
+	if !collectsValues(ag.name) || ag.result.Value != nil {
+		return
+	}
+	if ag.name == "count_distinct" {
+		seen := make(map[string]struct{})
+		for _, v := range ag.values {
+			if v.Value == nil {
+				continue
+			}
+			str := types.ValueForType(types.StringID)
+			if err := types.Marshal(v, &str); err != nil {
+				continue
+			}
+			seen[v.Tid.Name()+":"+str.Value.(string)] = struct{}{}
+		}
+		ag.result = types.Val{Tid: types.IntID, Value: int64(len(seen))}
+		ag.values = nil
+		return
+	}
+
+	nums, isTime := numbersOf(ag.values)
+	ag.values = nil
+	if len(nums) == 0 {
+		return
+	}
+	var res float64
+	switch ag.name {
+	case "median", "percentile":
+		sort.Float64s(nums)
+		// Interpolate between the closest ranks.
+		rank := ag.percentile * float64(len(nums)-1)
+		lo := int(math.Floor(rank))
+		res = nums[lo]
+		if lo+1 < len(nums) {
+			res += (rank - float64(lo)) * (nums[lo+1] - nums[lo])
+		}
+		if isTime {
+			sec, frac := math.Modf(res)
+			ag.result = types.Val{
+				Tid:   types.DateTimeID,
+				Value: time.Unix(int64(sec), int64(frac*1e9)).UTC(),
+			}
+			return
+		}
+	case "stddev", "variance":
+		// Of the population, in seconds for datetimes.
+		var sum float64
+		for _, n := range nums {
+			sum += n
+		}
+		mean := sum / float64(len(nums))
+		for _, n := range nums {
+			res += (n - mean) * (n - mean)
+		}
+		res /= float64(len(nums))
+		if ag.name == "stddev" {
+			res = math.Sqrt(res)
+		}
+	}
+	ag.result = types.Val{Tid: types.FloatID, Value: res}
+}
+
+// numbersOf returns the int and float values as floats, or the datetime values as seconds
+// since the epoch if the first of the values which are any of those is a datetime. The other
+// values are skipped.
+func numbersOf(vals []types.Val) ([]float64, bool) {
+	var nums []float64
+	var isTime, typed bool
+	for _, v := range vals {
+		switch v.Tid {
+		case types.IntID, types.FloatID:
+			if typed && isTime {
+				continue
+			}
+			if v.Tid == types.IntID {
+				nums = append(nums, float64(v.Value.(int64)))
+			} else {
+				nums = append(nums, v.Value.(float64))
+			}
+		case types.DateTimeID:
+			if typed && !isTime {
+				continue
+			}
+			isTime = true
+			t := v.Value.(time.Time)
+			nums = append(nums, float64(t.Unix())+float64(t.Nanosecond())/1e9)
+		default:
+			continue
+		}
+		typed = true
+	}
+	return nums, isTime
+}
+
 func (ag *aggregator) Value() (types.Val, error) {
+	ag.fromValues()
 	if ag.result.Value == nil {
 		return ag.result, ErrEmptyVal
 	}
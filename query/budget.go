@@ -0,0 +1,67 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package query
+
+import (
+	"sync/atomic"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// memoryBudget is the number of bytes the results of the tasks of a query may take, shared by
+// all the SubGraphs of the query through the context. Once it's used up, the query is cancelled.
+type memoryBudget struct {
+	limit  uint64
+	used   uint64 // Accessed atomically.
+	cancel context.CancelFunc
+}
+
+// budgetKey is the key of the memory budget in the context of a query.
+type budgetKey struct{}
+
+func budgetFromContext(ctx context.Context) *memoryBudget {
+	b, _ := ctx.Value(budgetKey{}).(*memoryBudget)
+	return b
+}
+
+// remaining returns the number of bytes left for the result of the next task. It's zero if
+// there is no budget.
+func (b *memoryBudget) remaining() uint64 {
+	if b == nil {
+		return 0
+	}
+	used := atomic.LoadUint64(&b.used)
+	if used >= b.limit {
+		// The task can't have any bytes, but zero would mean no budget.
+		return 1
+	}
+	return b.limit - used
+}
+
+// consume takes n bytes from the budget, cancelling the query if there aren't enough left.
+func (b *memoryBudget) consume(n uint64) error {
+	if b == nil {
+		return nil
+	}
+	if atomic.AddUint64(&b.used, n) > b.limit {
+		b.cancel()
+		return b.err()
+	}
+	return nil
+}
+
+func (b *memoryBudget) exceeded() bool {
+	return b != nil && atomic.LoadUint64(&b.used) > b.limit
+}
+
+func (b *memoryBudget) err() error {
+	return x.Errorf("Query was cancelled after its results took more than its memory budget"+
+		" of %d bytes", b.limit)
+}
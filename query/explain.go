@@ -0,0 +1,153 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package query
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/worker"
+)
+
+// execStats are the statistics collected while executing a SubGraph. They are written only by
+// the goroutine processing the SubGraph.
+type execStats struct {
+	tasks      int
+	groups     []uint32
+	indexKeys  uint64
+	bytes      int
+	filterTime time.Duration
+	sortTime   time.Duration
+}
+
+func (s *execStats) record(result *intern.Result) {
+	s.indexKeys += result.IndexKeys
+	s.addTask(result.GroupId, result.Size())
+}
+
+func (s *execStats) recordSort(result *intern.SortResult) {
+	s.addTask(result.GroupId, result.Size())
+}
+
+func (s *execStats) addTask(gid uint32, bytes int) {
+	s.tasks++
+	s.bytes += bytes
+	for _, g := range s.groups {
+		if g == gid {
+			return
+		}
+	}
+	s.groups = append(s.groups, gid)
+}
+
+// processTask runs a task for the SubGraph on the group serving its predicate and records the
+// statistics of the result. The task gets the deadline of the query and what is left of its
+// memory budget, which its result is taken from.
+func (sg *SubGraph) processTask(ctx context.Context, q *intern.Query) (*intern.Result, error) {
+	if d, ok := ctx.Deadline(); ok {
+		q.Deadline = d.UnixNano()
+	}
+	budget := budgetFromContext(ctx)
+	q.MemoryBudget = budget.remaining()
+	result, err := worker.ProcessTaskOverNetwork(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if err := budget.consume(uint64(result.Size())); err != nil {
+		return nil, err
+	}
+	sg.stats.record(result)
+	return result, nil
+}
+
+// processSort sorts the uid matrix of the SubGraph on the group serving the predicate sorted by,
+// within the deadline and memory budget of the query, as processTask does for tasks.
+func (sg *SubGraph) processSort(ctx context.Context, s *intern.SortMessage) (*intern.SortResult,
+	error) {
+	if d, ok := ctx.Deadline(); ok {
+		s.Deadline = d.UnixNano()
+	}
+	budget := budgetFromContext(ctx)
+	s.MemoryBudget = budget.remaining()
+	result, err := worker.SortOverNetwork(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	if err := budget.consume(uint64(result.Size())); err != nil {
+		return nil, err
+	}
+	sg.stats.recordSort(result)
+	return result, nil
+}
+
+// ExplainNode holds the statistics of executing a SubGraph, returned by queries run in explain
+// mode. Filters and children are the nodes of the filters and children of the SubGraph.
+type ExplainNode struct {
+	Attr  string `json:"attr,omitempty"`
+	Alias string `json:"alias,omitempty"`
+	Func  string `json:"func,omitempty"`
+	// Number of uids the SubGraph started from and ended with, after filters and pagination.
+	UidsIn  int `json:"uids_in"`
+	UidsOut int `json:"uids_out"`
+	// Number of tasks sent to ProcessTaskOverNetwork and SortOverNetwork, and the groups which
+	// served them.
+	Tasks  int      `json:"tasks"`
+	Groups []uint32 `json:"groups,omitempty"`
+	// Number of index keys read by the tasks and size in bytes of their results.
+	IndexKeys uint64 `json:"index_keys"`
+	Bytes     int    `json:"bytes"`
+	FilterNs  uint64 `json:"filter_ns"`
+	SortNs    uint64 `json:"sort_ns"`
+
+	Filters  []*ExplainNode `json:"filters,omitempty"`
+	Children []*ExplainNode `json:"children,omitempty"`
+}
+
+func (sg *SubGraph) explain() *ExplainNode {
+	n := &ExplainNode{
+		Attr:      sg.Attr,
+		Alias:     sg.Params.Alias,
+		Tasks:     sg.stats.tasks,
+		Groups:    sg.stats.groups,
+		IndexKeys: sg.stats.indexKeys,
+		Bytes:     sg.stats.bytes,
+		FilterNs:  uint64(sg.stats.filterTime.Nanoseconds()),
+		SortNs:    uint64(sg.stats.sortTime.Nanoseconds()),
+	}
+	if sg.SrcFunc != nil {
+		n.Func = sg.SrcFunc.Name
+	}
+	if sg.SrcUIDs != nil {
+		n.UidsIn = len(sg.SrcUIDs.Uids)
+	}
+	if sg.DestUIDs != nil {
+		n.UidsOut = len(sg.DestUIDs.Uids)
+	}
+	for _, f := range sg.Filters {
+		n.Filters = append(n.Filters, f.explain())
+	}
+	for _, c := range sg.Children {
+		if c.IsInternal() {
+			continue
+		}
+		n.Children = append(n.Children, c.explain())
+	}
+	return n
+}
+
+// Explain returns the execution statistics of the SubGraphs of a processed query, one tree per
+// query block.
+func Explain(sgl []*SubGraph) []*ExplainNode {
+	nodes := make([]*ExplainNode, 0, len(sgl))
+	for _, sg := range sgl {
+		nodes = append(nodes, sg.explain())
+	}
+	return nodes
+}
@@ -8,13 +8,17 @@
 package query
 
 import (
-	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
 
 	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -50,9 +54,9 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 	}
 	if child.SrcFunc != nil && isAggregatorFn(child.SrcFunc.Name) {
 		if fieldName == "" {
-			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
+			fieldName = aggregatorFieldName(child.SrcFunc, child.Attr)
 		}
-		finalVal, err := aggregateGroup(grp, child)
+		finalVal, err := aggregateGroup(grp, child, child.SrcFunc)
 		if err != nil {
 			return err
 		}
@@ -126,13 +130,246 @@ func (d *dedup) addValue(attr string, value types.Val, uid uint64) {
 		}
 	}
 	curEntity := cur.elements[strKey].entities
+	if n := len(curEntity.Uids); n > 0 && curEntity.Uids[n-1] == uid {
+		// The value was reached more than once through a multi-hop key.
+		return
+	}
 	curEntity.Uids = append(curEntity.Uids, uid)
 }
 
-func aggregateGroup(grp *groupResult, child *SubGraph) (types.Val, error) {
-	ag := aggregator{
-		name: child.SrcFunc.Name,
+// groupbyPath returns the predicates leading from the members of the groups to the values
+// they're grouped by, e.g. works_at and city for @groupby(works_at.city). Sorting by a path
+// splits it the same way. A predicate with dots in its name is a single hop if it has a schema.
+func groupbyPath(ctx context.Context, attr string) ([]string, error) {
+	if !strings.Contains(attr, ".") {
+		return []string{attr}, nil
+	}
+	nodes, err := worker.GetSchemaOverNetwork(ctx,
+		&intern.SchemaRequest{Predicates: []string{attr}})
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) > 0 {
+		return []string{attr}, nil
+	}
+	path := strings.Split(attr, ".")
+	for _, pred := range path {
+		if pred == "" {
+			return nil, x.Errorf("Invalid path of predicates: %s", attr)
+		}
+	}
+	return path, nil
+}
+
+// groupKeys returns the values the i-th source uid of the groupby key child is grouped by.
+func (child *SubGraph) groupKeys(i int) []types.Val {
+	var vals []types.Val
+	if len(child.Children) > 0 {
+		// It's a hop of a multi-hop key.
+		next := child.Children[0]
+		if i >= len(child.uidMatrix) {
+			return nil
+		}
+		for _, uid := range child.uidMatrix[i].Uids {
+			if j := algo.IndexOf(next.SrcUIDs, uid); j >= 0 {
+				vals = append(vals, next.groupKeys(j)...)
+			}
+		}
+		return vals
+	}
+	if len(child.DestUIDs.Uids) != 0 {
+		// It's a UID node.
+		if i >= len(child.uidMatrix) {
+			return nil
+		}
+		for _, uid := range child.uidMatrix[i].Uids {
+			vals = append(vals, types.Val{Tid: types.UidID, Value: uid})
+		}
+		return vals
+	}
+	// It's a value node.
+	if i >= len(child.valueMatrix) || len(child.valueMatrix[i].Values) == 0 {
+		return nil
+	}
+	val, err := convertTo(child.valueMatrix[i].Values[0])
+	if err != nil {
+		return nil
+	}
+	return append(vals, val)
+}
+
+// lastHop returns the last hop of the groupby key child, which holds the values grouped by.
+func (child *SubGraph) lastHop() *SubGraph {
+	for len(child.Children) > 0 {
+		child = child.Children[0]
+	}
+	return child
+}
+
+// isUidKey returns whether the groupby key child groups by uid.
+func (child *SubGraph) isUidKey() bool {
+	return len(child.lastHop().DestUIDs.Uids) != 0
+}
+
+// groupAggregates returns the aggregates the groups are filtered by in @having and ordered by.
+func (sg *SubGraph) groupAggregates() []*gql.GroupAggregate {
+	var aggs []*gql.GroupAggregate
+	var collect func(ft *gql.FilterTree)
+	collect = func(ft *gql.FilterTree) {
+		if ft == nil {
+			return
+		}
+		if ft.Func != nil && ft.Func.Aggregate != nil {
+			aggs = append(aggs, ft.Func.Aggregate)
+		}
+		for _, c := range ft.Child {
+			collect(c)
+		}
+	}
+	collect(sg.Params.having)
+	for _, o := range sg.Params.groupbyOrder {
+		aggs = append(aggs, o.Aggregate)
+	}
+	return aggs
+}
+
+// groupValueChild returns the child holding the values of the predicate aggregated by agg.
+func (sg *SubGraph) groupValueChild(agg *gql.GroupAggregate) *SubGraph {
+	for _, child := range sg.Children {
+		if child.Attr == agg.Attr && len(child.Children) == 0 &&
+			strings.Join(child.Params.Langs, ":") == strings.Join(agg.Langs, ":") {
+			return child
+		}
+	}
+	return nil
+}
+
+// groupAggregate returns the aggregate agg of the members of grp.
+func (sg *SubGraph) groupAggregate(grp *groupResult, agg *gql.GroupAggregate) (types.Val, error) {
+	if agg.Name == "count" {
+		return types.Val{Tid: types.IntID, Value: int64(len(grp.uids))}, nil
+	}
+	child := sg.groupValueChild(agg)
+	if child == nil {
+		return types.Val{}, ErrEmptyVal
+	}
+	return aggregateGroup(grp, child, &Function{Name: agg.Name, Args: agg.Args})
+}
+
+// evalHaving returns whether grp satisfies the @having filter ft.
+func (sg *SubGraph) evalHaving(grp *groupResult, ft *gql.FilterTree) (bool, error) {
+	if ft.Func != nil {
+		v, err := sg.groupAggregate(grp, ft.Func.Aggregate)
+		if err == ErrEmptyVal {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return compareAggregate(ft.Func.Name, v, ft.Func.Args[0].Value)
+	}
+	switch ft.Op {
+	case "not":
+		ok, err := sg.evalHaving(grp, ft.Child[0])
+		return !ok, err
+	case "and", "or":
+		for _, c := range ft.Child {
+			ok, err := sg.evalHaving(grp, c)
+			if err != nil {
+				return false, err
+			}
+			if ok == (ft.Op == "or") {
+				return ok, nil
+			}
+		}
+		return ft.Op == "and", nil
+	}
+	return false, x.Errorf("Unknown operator %s in @having", ft.Op)
+}
+
+// compareAggregate compares the aggregate v with the value arg of the @having function op.
+func compareAggregate(op string, v types.Val, arg string) (bool, error) {
+	src := types.Val{Tid: types.StringID, Value: []byte(arg)}
+	ref, err := types.Convert(src, v.Tid)
+	if err != nil && v.Tid == types.IntID {
+		// Compare the integer with a fractional value as a float.
+		v = types.Val{Tid: types.FloatID, Value: float64(v.Value.(int64))}
+		ref, err = types.Convert(src, types.FloatID)
+	}
+	if err != nil {
+		return false, x.Wrapf(err, "While comparing with %s in @having", arg)
+	}
+	return types.CompareVals(op, v, ref), nil
+}
+
+// filterGroups keeps the groups which satisfy @having.
+func (sg *SubGraph) filterGroups(res *groupResults) error {
+	if sg.Params.having == nil {
+		return nil
+	}
+	groups := res.group[:0]
+	for _, grp := range res.group {
+		ok, err := sg.evalHaving(grp, sg.Params.having)
+		if err != nil {
+			return err
+		}
+		if ok {
+			groups = append(groups, grp)
+		}
+	}
+	res.group = groups
+	return nil
+}
+
+// orderGroups sorts the groups by the aggregates given by orderasc and orderdesc in
+// @groupby, and applies its offset and first.
+func (sg *SubGraph) orderGroups(res *groupResults) error {
+	if order := sg.Params.groupbyOrder; len(order) > 0 {
+		keys := make(map[*groupResult][]types.Val, len(res.group))
+		for _, grp := range res.group {
+			vals := make([]types.Val, len(order))
+			for i, o := range order {
+				v, err := sg.groupAggregate(grp, o.Aggregate)
+				if err != nil && err != ErrEmptyVal {
+					return err
+				}
+				vals[i] = v
+			}
+			keys[grp] = vals
+		}
+		sort.SliceStable(res.group, func(i, j int) bool {
+			a, b := keys[res.group[i]], keys[res.group[j]]
+			for k, o := range order {
+				// Groups without the aggregate come last.
+				if a[k].Value == nil || b[k].Value == nil {
+					if (a[k].Value == nil) != (b[k].Value == nil) {
+						return b[k].Value == nil
+					}
+					continue
+				}
+				if l, err := types.Less(a[k], b[k]); err == nil && l {
+					return !o.Desc
+				}
+				if l, err := types.Less(b[k], a[k]); err == nil && l {
+					return o.Desc
+				}
+			}
+			return false
+		})
 	}
+	offset := sg.Params.groupbyOffset
+	if offset > len(res.group) {
+		offset = len(res.group)
+	}
+	res.group = res.group[offset:]
+	if first := sg.Params.groupbyFirst; first > 0 && first < len(res.group) {
+		res.group = res.group[:first]
+	}
+	return nil
+}
+
+func aggregateGroup(grp *groupResult, child *SubGraph, fn *Function) (types.Val, error) {
+	ag := newAggregator(fn)
 	for _, uid := range grp.uids {
 		idx := sort.Search(len(child.SrcUIDs.Uids), func(i int) bool {
 			return child.SrcUIDs.Uids[i] >= uid
@@ -205,30 +442,12 @@ func (sg *SubGraph) formResult(ul *intern.List) (*groupResults, error) {
 		if attr == "" {
 			attr = child.Attr
 		}
-		if len(child.DestUIDs.Uids) != 0 {
-			// It's a UID node.
-			for i := 0; i < len(child.uidMatrix); i++ {
-				srcUid := child.SrcUIDs.Uids[i]
-				// Ignore uids which are not part of srcUid.
-				if algo.IndexOf(ul, srcUid) < 0 {
-					continue
-				}
-				ul := child.uidMatrix[i]
-				for _, uid := range ul.Uids {
-					dedupMap.addValue(attr, types.Val{Tid: types.UidID, Value: uid}, srcUid)
-				}
+		for i, srcUid := range child.SrcUIDs.Uids {
+			// Ignore uids which are not part of srcUid.
+			if algo.IndexOf(ul, srcUid) < 0 {
+				continue
 			}
-		} else {
-			// It's a value node.
-			for i, v := range child.valueMatrix {
-				srcUid := child.SrcUIDs.Uids[i]
-				if len(v.Values) == 0 || algo.IndexOf(ul, srcUid) < 0 {
-					continue
-				}
-				val, err := convertTo(v.Values[0])
-				if err != nil {
-					continue
-				}
+			for _, val := range child.groupKeys(i) {
 				dedupMap.addValue(attr, val, srcUid)
 			}
 		}
@@ -236,6 +455,9 @@ func (sg *SubGraph) formResult(ul *intern.List) (*groupResults, error) {
 
 	// Create all the groups here.
 	res.formGroups(dedupMap, &intern.List{}, []groupPair{})
+	if err := sg.filterGroups(res); err != nil {
+		return res, err
+	}
 
 	// Go over the groups and aggregate the values.
 	for _, child := range sg.Children {
@@ -254,6 +476,9 @@ func (sg *SubGraph) formResult(ul *intern.List) (*groupResults, error) {
 	sort.Slice(res.group, func(i, j int) bool {
 		return groupLess(res.group[i], res.group[j])
 	})
+	if err := sg.orderGroups(res); err != nil {
+		return res, err
+	}
 
 	return res, nil
 }
@@ -285,35 +510,22 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 		if attr == "" {
 			attr = child.Attr
 		}
-		if len(child.DestUIDs.Uids) != 0 {
-			// It's a UID node.
-			for i := 0; i < len(child.uidMatrix); i++ {
-				srcUid := child.SrcUIDs.Uids[i]
-				ul := child.uidMatrix[i]
-				for _, uid := range ul.Uids {
-					dedupMap.addValue(attr, types.Val{Tid: types.UidID, Value: uid}, srcUid)
-				}
-			}
-			pathNode = child
-		} else {
-			// It's a value node.
-			for i, v := range child.valueMatrix {
-				srcUid := child.SrcUIDs.Uids[i]
-				if len(v.Values) == 0 {
-					continue
-				}
-				val, err := convertTo(v.Values[0])
-				if err != nil {
-					continue
-				}
+		for i, srcUid := range child.SrcUIDs.Uids {
+			for _, val := range child.groupKeys(i) {
 				dedupMap.addValue(attr, val, srcUid)
 			}
 		}
+		if child.isUidKey() {
+			pathNode = child.lastHop()
+		}
 	}
 
 	// Create all the groups here.
 	res := new(groupResults)
 	res.formGroups(dedupMap, &intern.List{}, []groupPair{})
+	if err := sg.filterGroups(res); err != nil {
+		return err
+	}
 
 	// Go over the groups and aggregate the values.
 	for _, child := range sg.Children {
@@ -11,6 +11,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"golang.org/x/net/trace"
@@ -18,6 +20,8 @@ import (
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
@@ -51,10 +55,15 @@ func ApplyMutations(ctx context.Context, m *intern.Mutations) (*api.TxnContext,
 }
 
 func expandEdges(ctx context.Context, m *intern.Mutations) ([]*intern.DirectedEdge, error) {
+	typeFieldsOf, err := nodeTypeFields(ctx, m)
+	if err != nil {
+		return nil, err
+	}
 	edges := make([]*intern.DirectedEdge, 0, 2*len(m.Edges))
 	for _, edge := range m.Edges {
 		x.AssertTrue(edge.Op == intern.DirectedEdge_DEL || edge.Op == intern.DirectedEdge_SET)
 
+		fields, typed := typeFieldsOf[edge.GetEntity()]
 		var preds []string
 		if edge.Attr != x.Star {
 			preds = []string{edge.Attr}
@@ -75,6 +84,12 @@ func expandEdges(ctx context.Context, m *intern.Mutations) ([]*intern.DirectedEd
 					preds = append(preds, string(tv.Val))
 				}
 			}
+			if typed {
+				// The predicates of a typed node are the fields of its types.
+				preds = append(preds, fields...)
+				preds = append(preds, x.TypeAttr)
+				preds = x.RemoveDuplicates(preds)
+			}
 		}
 
 		for _, pred := range preds {
@@ -82,6 +97,11 @@ func expandEdges(ctx context.Context, m *intern.Mutations) ([]*intern.DirectedEd
 			edgeCopy.Attr = pred
 			edges = append(edges, &edgeCopy)
 
+			// Typed nodes are expanded to the fields of their types, so their predicates
+			// aren't kept in <uid> + <_predicate_>.
+			if typed {
+				continue
+			}
 			// We only want to delete the pred from <uid> + <_predicate_> posting list if this is
 			// a SP* deletion operation. Otherwise we just continue.
 			if edge.Op == intern.DirectedEdge_DEL && string(edge.Value) != x.Star {
@@ -100,6 +120,48 @@ func expandEdges(ctx context.Context, m *intern.Mutations) ([]*intern.DirectedEd
 	return edges, nil
 }
 
+// nodeTypeFields returns the fields of the types of the entities of the mutation which have a
+// defined type, either already or through a _type_ edge set by the mutation.
+func nodeTypeFields(ctx context.Context,
+	m *intern.Mutations) (map[uint64][]string, error) {
+	typeFieldsOf := make(map[uint64][]string)
+	if len(schema.State().Types()) == 0 {
+		return typeFieldsOf, nil
+	}
+
+	var uids []uint64
+	for _, edge := range m.Edges {
+		uids = append(uids, edge.GetEntity())
+		if edge.Attr != x.TypeAttr || edge.Op != intern.DirectedEdge_SET {
+			continue
+		}
+		if typ, ok := schema.State().GetType(string(edge.Value)); ok {
+			typeFieldsOf[edge.GetEntity()] = append(typeFieldsOf[edge.GetEntity()],
+				typ.Fields...)
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	sg := &SubGraph{DestUIDs: &intern.List{}, ReadTs: m.StartTs}
+	for i, uid := range uids {
+		if i == 0 || uids[i-1] != uid {
+			sg.DestUIDs.Uids = append(sg.DestUIDs.Uids, uid)
+		}
+	}
+	typeLists, err := getNodeTypes(ctx, sg)
+	if err != nil {
+		return nil, err
+	}
+	for i, uid := range sg.DestUIDs.Uids {
+		if i >= len(typeLists) {
+			break
+		}
+		if fields := typeFields(typeLists[i]); len(fields) > 0 {
+			typeFieldsOf[uid] = append(typeFieldsOf[uid], fields...)
+		}
+	}
+	return typeFieldsOf, nil
+}
+
 func verifyUid(ctx context.Context, uid uint64) error {
 	if uid <= worker.MaxLeaseId() {
 		return nil
@@ -214,3 +276,182 @@ func ToInternal(gmu *gql.Mutation,
 
 	return edges, nil
 }
+
+// ExpandVarsInMutation replaces uid(v) and val(v) in the N-Quads of an upsert mutation with the
+// values of the variables computed by the query of the upsert block. An N-Quad is repeated for
+// every uid in the uid variables that it refers to. If a uid variable is empty, set N-Quads get
+// a blank node instead, so that a new node is created, whereas delete N-Quads are dropped.
+func (req *QueryRequest) ExpandVarsInMutation(gmu *gql.Mutation) (err error) {
+	if gmu.Set, err = req.expandVarsInNQuads(gmu.Set, true); err != nil {
+		return err
+	}
+	gmu.Del, err = req.expandVarsInNQuads(gmu.Del, false)
+	return err
+}
+
+func (req *QueryRequest) expandVarsInNQuads(nqs []*api.NQuad,
+	isSet bool) ([]*api.NQuad, error) {
+	out := make([]*api.NQuad, 0, len(nqs))
+	for _, nq := range nqs {
+		subjects, err := req.uidsForRef(nq.Subject, isSet)
+		if err != nil {
+			return nil, err
+		}
+		valVar, isValVar := gql.ValVar(nq.ObjectId)
+		objects := []string{nq.ObjectId}
+		if isValVar {
+			objects = []string{""}
+		} else if len(nq.ObjectId) > 0 {
+			if objects, err = req.uidsForRef(nq.ObjectId, isSet); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, s := range subjects {
+			var objVal *api.Value
+			if isValVar {
+				if objVal, err = req.valueForUid(valVar, s); err != nil {
+					return nil, err
+				}
+				if objVal == nil {
+					// No value for this uid, so there is nothing to set or delete.
+					continue
+				}
+			}
+			for _, o := range objects {
+				nqCopy := *nq
+				nqCopy.Subject = s
+				nqCopy.ObjectId = o
+				if isValVar {
+					nqCopy.ObjectValue = objVal
+				}
+				out = append(out, &nqCopy)
+			}
+		}
+	}
+	return out, nil
+}
+
+// uidsForRef returns the list of subjects/objects to use in place of ref. If ref isn't of the
+// form uid(v), it is returned as such.
+func (req *QueryRequest) uidsForRef(ref string, isSet bool) ([]string, error) {
+	name, ok := gql.UidVar(ref)
+	if !ok {
+		return []string{ref}, nil
+	}
+	v, ok := req.vars[name]
+	if !ok {
+		return nil, x.Errorf("Variable %q used in mutation is not defined in the query", name)
+	}
+
+	var uids []uint64
+	if v.Uids != nil {
+		uids = v.Uids.Uids
+	} else {
+		// Derive the uid list from the value variable.
+		for uid := range v.Vals {
+			if uid > 0 {
+				uids = append(uids, uid)
+			}
+		}
+		sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	}
+
+	if len(uids) == 0 {
+		if isSet {
+			return []string{"_:" + ref}, nil
+		}
+		return nil, nil
+	}
+	res := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		res = append(res, fmt.Sprintf("%#x", uid))
+	}
+	return res, nil
+}
+
+// valueForUid returns the value of the value variable name for the given subject, or nil if the
+// subject doesn't have one.
+func (req *QueryRequest) valueForUid(name, subject string) (*api.Value, error) {
+	v, ok := req.vars[name]
+	if !ok {
+		return nil, x.Errorf("Variable %q used in mutation is not defined in the query", name)
+	}
+	uid, err := strconv.ParseUint(subject, 0, 64)
+	if err != nil {
+		// The subject is a new node.
+		uid = 0
+	}
+	val, ok := v.Vals[uid]
+	if !ok {
+		// Aggregations at an empty block are stored against uid 0.
+		val, ok = v.Vals[0]
+	}
+	if !ok || val.Value == nil {
+		return nil, nil
+	}
+	return types.ObjectValue(val.Tid, val.Value)
+}
+
+// EvalCond evaluates the condition of a conditional upsert mutation on the variables computed by
+// the query of the upsert block.
+func (req *QueryRequest) EvalCond(cond *gql.FilterTree) (bool, error) {
+	if f := cond.Func; f != nil {
+		if !f.IsLenVar || len(f.Args) != 1 {
+			return false, x.Errorf("Invalid function in condition: %s", f.Name)
+		}
+		// The variable is defined in the query, as checked while parsing it. It isn't populated
+		// if the query block didn't match anything, in which case its length is zero.
+		v := req.vars[f.Attr]
+		arg, err := strconv.ParseInt(f.Args[0].Value, 0, 64)
+		if err != nil {
+			return false, x.Wrapf(err, "While parsing argument of %s", f.Name)
+		}
+		return compareLen(f.Name, int64(v.len()), arg)
+	}
+
+	var res bool
+	for i, ch := range cond.Child {
+		r, err := req.EvalCond(ch)
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case cond.Op == "not":
+			return !r, nil
+		case i == 0:
+			res = r
+		case cond.Op == "and":
+			res = res && r
+		case cond.Op == "or":
+			res = res || r
+		default:
+			return false, x.Errorf("Invalid operator in condition: %q", cond.Op)
+		}
+	}
+	return res, nil
+}
+
+// len returns the number of uids in a uid variable or the number of values in a value variable.
+func (v varValue) len() int {
+	if v.Uids != nil {
+		return len(v.Uids.Uids)
+	}
+	return len(v.Vals)
+}
+
+func compareLen(fn string, l, arg int64) (bool, error) {
+	switch fn {
+	case "eq":
+		return l == arg, nil
+	case "le":
+		return l <= arg, nil
+	case "lt":
+		return l < arg, nil
+	case "ge":
+		return l >= arg, nil
+	case "gt":
+		return l > arg, nil
+	}
+	return false, x.Errorf("Invalid function in condition: %s", fn)
+}
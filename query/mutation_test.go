@@ -0,0 +1,119 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func upsertVars() map[string]varValue {
+	return map[string]varValue{
+		"u": {Uids: &intern.List{Uids: []uint64{1, 2}}},
+		"v": {Uids: &intern.List{Uids: []uint64{3}}},
+		"e": {Uids: &intern.List{}},
+		"n": {Vals: map[uint64]types.Val{
+			1: {Tid: types.StringID, Value: "a"},
+			3: {Tid: types.StringID, Value: "c"},
+		}},
+	}
+}
+
+func TestExpandUidVarsInMutation(t *testing.T) {
+	req := &QueryRequest{vars: upsertVars()}
+	gmu := &gql.Mutation{
+		Set: []*api.NQuad{{Subject: "uid(u)", Predicate: "friend", ObjectId: "uid(v)"}},
+		Del: []*api.NQuad{{Subject: "uid(v)", Predicate: "friend", ObjectId: "uid(u)"}},
+	}
+	require.NoError(t, req.ExpandVarsInMutation(gmu))
+	require.Equal(t, []*api.NQuad{
+		{Subject: "0x1", Predicate: "friend", ObjectId: "0x3"},
+		{Subject: "0x2", Predicate: "friend", ObjectId: "0x3"},
+	}, gmu.Set)
+	require.Equal(t, []*api.NQuad{
+		{Subject: "0x3", Predicate: "friend", ObjectId: "0x1"},
+		{Subject: "0x3", Predicate: "friend", ObjectId: "0x2"},
+	}, gmu.Del)
+}
+
+func TestExpandValVarsInMutation(t *testing.T) {
+	req := &QueryRequest{vars: upsertVars()}
+	quoted := &api.Value{&api.Value_DefaultVal{"val(n)"}}
+	gmu := &gql.Mutation{
+		Set: []*api.NQuad{
+			{Subject: "uid(u)", Predicate: "nick", ObjectId: "val(n)"},
+			{Subject: "uid(u)", Predicate: "note", ObjectValue: quoted},
+		},
+	}
+	require.NoError(t, req.ExpandVarsInMutation(gmu))
+	// 0x2 has no value in n, so nothing is set for it.
+	require.Equal(t, []*api.NQuad{
+		{Subject: "0x1", Predicate: "nick", ObjectValue: &api.Value{&api.Value_StrVal{"a"}}},
+		{Subject: "0x1", Predicate: "note", ObjectValue: quoted},
+		{Subject: "0x2", Predicate: "note", ObjectValue: quoted},
+	}, gmu.Set)
+}
+
+func TestExpandEmptyVarInMutation(t *testing.T) {
+	req := &QueryRequest{vars: upsertVars()}
+	gmu := &gql.Mutation{
+		Set: []*api.NQuad{{Subject: "uid(e)", Predicate: "email",
+			ObjectValue: &api.Value{&api.Value_DefaultVal{"a@b.com"}}}},
+		Del: []*api.NQuad{{Subject: "uid(e)", Predicate: "email",
+			ObjectValue: &api.Value{&api.Value_DefaultVal{"*"}}}},
+	}
+	require.NoError(t, req.ExpandVarsInMutation(gmu))
+	// A set N-Quad gets a blank node in place of the empty variable, a delete N-Quad is dropped.
+	require.Equal(t, []*api.NQuad{{Subject: "_:uid(e)", Predicate: "email",
+		ObjectValue: &api.Value{&api.Value_DefaultVal{"a@b.com"}}}}, gmu.Set)
+	require.Empty(t, gmu.Del)
+}
+
+func TestExpandUndefinedVarInMutation(t *testing.T) {
+	req := &QueryRequest{vars: upsertVars()}
+	gmu := &gql.Mutation{
+		Set: []*api.NQuad{{Subject: "uid(w)", Predicate: "friend", ObjectId: "uid(v)"}},
+	}
+	require.Error(t, req.ExpandVarsInMutation(gmu))
+
+	gmu = &gql.Mutation{
+		Set: []*api.NQuad{{Subject: "uid(u)", Predicate: "nick", ObjectId: "val(w)"}},
+	}
+	require.Error(t, req.ExpandVarsInMutation(gmu))
+}
+
+func TestEvalCond(t *testing.T) {
+	req := &QueryRequest{vars: upsertVars()}
+	// w isn't populated, like the variables of a query block that matched nothing.
+	for cond, want := range map[string]bool{
+		"eq(len(u), 2)":                       true,
+		"gt(len(u), 2)":                       false,
+		"le(len(v), 1)":                       true,
+		"lt(len(n), 2)":                       false,
+		"ge(len(n), 2)":                       true,
+		"eq(len(e), 0)":                       true,
+		"gt(len(e), 0)":                       false,
+		"eq(len(w), 0)":                       true,
+		"eq(len(u), 2) and eq(len(e), 1)":     false,
+		"eq(len(u), 2) or eq(len(e), 1)":      true,
+		"not eq(len(e), 0)":                   false,
+		"eq(len(v), 1) and not gt(len(u), 2)": true,
+	} {
+		ft, err := gql.ParseCond(cond)
+		require.NoError(t, err, cond)
+		got, err := req.EvalCond(ft)
+		require.NoError(t, err, cond)
+		require.Equal(t, want, got, cond)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -21,12 +22,18 @@ import (
 
 	"github.com/dgraph-io/dgo/protos/api"
 	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
 	"github.com/dgraph-io/dgraph/x"
 )
 
 const (
 	normalizeLimit = 10000
+
+	// Marker tags put around the matches in the values of predicates asked with @highlight.
+	highlightPre  = "<em>"
+	highlightPost = "</em>"
 )
 
 // ToJson converts the list of subgraph into a JSON response by calling toFastJSON.
@@ -159,11 +166,157 @@ func valToBytes(v types.Val) ([]byte, error) {
 		return []byte(fmt.Sprintf("\"%#x\"", v.Value)), nil
 	case types.PasswordID:
 		return []byte(fmt.Sprintf("%q", v.Value.(string))), nil
+	case types.VFloatID:
+		return []byte(types.VFloatToString(v.Value.([]float32))), nil
 	default:
 		return nil, errors.New("unsupported types.Val.Tid")
 	}
 }
 
+type tokenMatcher struct {
+	tokenizer tok.Tokenizer
+	tokens    map[string]bool
+}
+
+// highlighter locates in the values of a predicate the parts matched by the term, full text and
+// regexp functions applied to that predicate by the block the values are fetched in.
+type highlighter struct {
+	matchers []tokenMatcher
+	regexps  []*regexp.Regexp
+}
+
+func (h *highlighter) addTokens(tokenizer tok.Tokenizer, args []string) error {
+	m := tokenMatcher{tokenizer: tokenizer, tokens: make(map[string]bool)}
+	for _, arg := range args {
+		tokens, err := tok.BuildTokens(arg, tokenizer)
+		if err != nil {
+			return err
+		}
+		for _, t := range tokens {
+			m.tokens[t] = true
+		}
+	}
+	h.matchers = append(h.matchers, m)
+	return nil
+}
+
+// addFunctions adds the matches of the functions of sg and of its filters which apply to attr.
+// The functions under a not don't match anything in the nodes they let through.
+func (h *highlighter) addFunctions(sg *SubGraph, attr string) error {
+	if sg.FilterOp == "not" {
+		return nil
+	}
+	if sg.SrcFunc != nil && sg.Attr == attr {
+		var args []string
+		for _, arg := range sg.SrcFunc.Args {
+			if !arg.IsValueVar {
+				args = append(args, arg.Value)
+			}
+		}
+		switch sg.SrcFunc.Name {
+		case "anyofterms", "allofterms":
+			if err := h.addTokens(tok.TermTokenizer{}, args); err != nil {
+				return err
+			}
+		case "anyoftext", "alloftext":
+			var lang string
+			if len(sg.Params.Langs) > 0 {
+				lang = sg.Params.Langs[0]
+			}
+			tokenizer, err := worker.FullTextTokenizer(attr, lang)
+			if err != nil {
+				return err
+			}
+			if err := h.addTokens(tokenizer, args); err != nil {
+				return err
+			}
+		case "regexp":
+			if len(args) != 2 {
+				break
+			}
+			// Same flags as the regexp function uses to match the values.
+			flags := "(?m)"
+			if args[1] == "i" {
+				flags = "(?i)" + flags
+			}
+			re, err := regexp.Compile(flags + args[0])
+			if err != nil {
+				return err
+			}
+			h.regexps = append(h.regexps, re)
+		}
+	}
+	for _, f := range sg.Filters {
+		if err := h.addFunctions(f, attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// highlight puts the marker tags around the parts of str matched by the functions.
+func (h *highlighter) highlight(str string) string {
+	var spans [][2]int
+	for _, m := range h.matchers {
+		tokens, err := tok.TokenSpans(m.tokenizer, str)
+		if err != nil {
+			continue
+		}
+		for _, t := range tokens {
+			if m.tokens[t.Token] {
+				spans = append(spans, [2]int{t.Start, t.End})
+			}
+		}
+	}
+	for _, re := range h.regexps {
+		for _, loc := range re.FindAllStringIndex(str, -1) {
+			if loc[1] > loc[0] {
+				spans = append(spans, [2]int{loc[0], loc[1]})
+			}
+		}
+	}
+	if len(spans) == 0 {
+		return str
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	var buf bytes.Buffer
+	last := 0
+	for i := 0; i < len(spans); {
+		start, end := spans[i][0], spans[i][1]
+		// Overlapping matches are marked as one.
+		for i++; i < len(spans) && spans[i][0] < end; i++ {
+			if spans[i][1] > end {
+				end = spans[i][1]
+			}
+		}
+		buf.WriteString(str[last:start])
+		buf.WriteString(highlightPre)
+		buf.WriteString(str[start:end])
+		buf.WriteString(highlightPost)
+		last = end
+	}
+	buf.WriteString(str[last:])
+	return buf.String()
+}
+
+// highlight returns the value v of the child pc with its parts matched by the functions of sg
+// marked.
+func (sg *SubGraph) highlight(pc *SubGraph, v types.Val) (types.Val, error) {
+	if v.Tid != types.StringID && v.Tid != types.DefaultID {
+		return v, nil
+	}
+	if pc.highlighter == nil {
+		h := &highlighter{}
+		if err := h.addFunctions(sg, pc.Attr); err != nil {
+			return v, err
+		}
+		pc.highlighter = h
+	}
+	v.Value = pc.highlighter.highlight(v.Value.(string))
+	return v, nil
+}
+
 type nodeSlice []*fastJsonNode
 
 func (n nodeSlice) Len() int {
@@ -477,6 +630,7 @@ func processNodeUids(n *fastJsonNode, sg *SubGraph) error {
 type Extensions struct {
 	Latency *api.Latency    `json:"server_latency,omitempty"`
 	Txn     *api.TxnContext `json:"txn,omitempty"`
+	Explain []*ExplainNode  `json:"explain,omitempty"`
 }
 
 func (sg *SubGraph) toFastJSON(l *Latency) ([]byte, error) {
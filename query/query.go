@@ -9,8 +9,10 @@ package query
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"math/rand"
 	"sort"
 	"strconv"
 	"strings"
@@ -25,6 +27,7 @@ import (
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/task"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
@@ -120,6 +123,12 @@ type params struct {
 	RecurseArgs  gql.RecurseArgs
 	Cascade      bool
 	IgnoreReflex bool
+	Highlight    bool
+	// Children required by @cascade, all of them if empty.
+	CascadeFields []string
+	// Number of uids sampled at random from every uid list, and the seed of the sample.
+	Random int
+	Seed   int64
 
 	From           uint64
 	To             uint64
@@ -127,11 +136,16 @@ type params struct {
 	FacetOrder     string
 	FacetOrderDesc bool
 	ExploreDepth   uint64
+	AfterCursor    *intern.SortCursor
 	isInternal     bool   // Determines if processTask has to be called or not.
 	ignoreResult   bool   // Node results are ignored.
 	Expand         string // Value is either _all_/variable-name or empty.
 	isGroupBy      bool
 	groupbyAttrs   []gql.GroupByAttr
+	groupbyOrder   []gql.GroupByOrder
+	groupbyFirst   int
+	groupbyOffset  int
+	having         *gql.FilterTree
 	uidCount       bool
 	uidCountAlias  string
 	numPaths       int
@@ -164,6 +178,15 @@ type SubGraph struct {
 	ExpandPreds  []*intern.ValueList
 	GroupbyRes   []*groupResults // one result for each uid list.
 	LangTags     []*intern.LangList
+	// Values computed by the function at root, like the distances of the neighbours found by
+	// similar_to or the scores of full text search.
+	funcVals map[uint64]types.Val
+	// Marks the matches in the values of the predicate, if asked with @highlight.
+	highlighter *highlighter
+	// Statistics of the execution, returned in explain mode.
+	stats execStats
+	// Cursors of the nodes, if asked for with _cursor_.
+	cursors map[uint64]string
 
 	// SrcUIDs is a list of unique source UIDs. They are always copies of destUIDs
 	// of parent nodes in GraphQL structure.
@@ -304,7 +327,7 @@ func aggWithVarFieldName(pc *SubGraph) string {
 	if len(pc.Params.NeedsVar) > 0 {
 		fieldName = fmt.Sprintf("val(%v)", pc.Params.NeedsVar[0].Name)
 		if pc.SrcFunc != nil {
-			fieldName = fmt.Sprintf("%s(%v)", pc.SrcFunc.Name, fieldName)
+			fieldName = aggregatorFieldName(pc.SrcFunc, fieldName)
 		}
 	}
 	if pc.Params.Alias != "" {
@@ -481,6 +504,13 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 				continue
 			}
 
+			if pc.Attr == cursorAttr {
+				if c, ok := sg.cursors[uid]; ok {
+					dst.AddValue(pc.fieldName(), types.Val{Tid: types.StringID, Value: c})
+				}
+				continue
+			}
+
 			if pc.Params.Facet != nil && len(pc.facetsMatrix[idx].FacetsList) > 0 {
 				// in case of Value we have only one Facets
 				for _, f := range pc.facetsMatrix[idx].FacetsList[0].Facets {
@@ -498,6 +528,11 @@ func (sg *SubGraph) preTraverse(uid uint64, dst outputNode) error {
 				if convErr != nil {
 					return convErr
 				}
+				if pc.Params.Highlight {
+					if sv, convErr = sg.highlight(pc, sv); convErr != nil {
+						return convErr
+					}
+				}
 
 				if pc.Params.expandAll && len(pc.LangTags[idx].Lang) != 0 {
 					if i >= len(pc.LangTags[idx].Lang) {
@@ -581,11 +616,28 @@ func filterCopy(sg *SubGraph, ft *gql.FilterTree) error {
 	// Either we'll have an operation specified, or the function specified.
 	if len(ft.Op) > 0 {
 		sg.FilterOp = ft.Op
+	} else if ft.Func.Name == "exists" {
+		// The nodes reached through the predicate are fetched by a child, with the filter of
+		// exists, and the filter keeps the nodes which reach any.
+		sg.Attr = ft.Func.Attr
+		sg.SrcFunc = &Function{Name: ft.Func.Name}
+		child := &SubGraph{Attr: ft.Func.Attr}
+		if ft.Func.Filter != nil {
+			dstf := &SubGraph{}
+			if err := filterCopy(dstf, ft.Func.Filter); err != nil {
+				return err
+			}
+			child.Filters = append(child.Filters, dstf)
+		}
+		sg.Children = append(sg.Children, child)
 	} else {
 		sg.Attr = ft.Func.Attr
 		if !isValidFuncName(ft.Func.Name) {
 			return x.Errorf("Invalid function name : %s", ft.Func.Name)
 		}
+		if ft.Func.IsLenVar {
+			return x.Errorf("len() can only be used in the condition of an upsert mutation")
+		}
 
 		isUidFuncWithoutVar := isUidFnWithoutVar(ft.Func)
 		if isUidFuncWithoutVar {
@@ -655,6 +707,9 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 	// sg.ReadTs = readTs
 
 	for _, gchild := range gq.Children {
+		if gchild.Skipped() {
+			continue
+		}
 		if sg.Params.Alias == "shortest" && gchild.Expand != "" {
 			return x.Errorf("expand() not allowed inside shortest")
 		}
@@ -681,13 +736,19 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 			Expand:         gchild.Expand,
 			isGroupBy:      gchild.IsGroupby,
 			groupbyAttrs:   gchild.GroupbyAttrs,
+			groupbyOrder:   gchild.GroupbyOrder,
+			groupbyFirst:   gchild.GroupbyFirst,
+			groupbyOffset:  gchild.GroupbyOffset,
+			having:         gchild.Having,
 			FacetVar:       gchild.FacetVar,
 			uidCount:       gchild.UidCount,
 			uidCountAlias:  gchild.UidCountAlias,
-			Cascade:        sg.Params.Cascade,
+			Cascade:        gchild.Cascade || sg.Params.cascadesAll(),
+			CascadeFields:  gchild.CascadeFields,
 			FacetOrder:     gchild.FacetOrder,
 			FacetOrderDesc: gchild.FacetDesc,
 			IgnoreReflex:   sg.Params.IgnoreReflex,
+			Highlight:      gchild.Highlight,
 			Order:          gchild.Order,
 			Facet:          gchild.Facets,
 		}
@@ -699,6 +760,9 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 			}
 			args.DoCount = true
 		}
+		if gchild.Highlight && len(gchild.Children) != 0 {
+			return errors.New("Node with @highlight cannot have child attributes")
+		}
 
 		for argk := range gchild.Args {
 			if !isValidArg(argk) {
@@ -769,6 +833,9 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 }
 
 func (args *params) fill(gq *gql.GraphQuery) error {
+	if gq.Having != nil && !gq.IsGroupby {
+		return x.Errorf("@having can only be used along with @groupby")
+	}
 	if v, ok := gq.Args["offset"]; ok {
 		offset, err := strconv.ParseInt(v, 0, 32)
 		if err != nil {
@@ -777,11 +844,19 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 		args.Offset = int(offset)
 	}
 	if v, ok := gq.Args["after"]; ok {
-		after, err := strconv.ParseUint(v, 0, 64)
-		if err != nil {
-			return err
+		if after, err := strconv.ParseUint(v, 0, 64); err == nil {
+			args.AfterUID = uint64(after)
+		} else if args.AfterCursor, err = decodeCursor(strings.Trim(v, `"`)); err != nil {
+			return x.Errorf("Expected a uid or a cursor for after, got: %s", v)
+		}
+	}
+	if args.AfterCursor != nil {
+		if len(args.Order) == 0 {
+			return x.Errorf("A cursor in after can only be used along with orderasc or orderdesc")
+		}
+		if args.Offset > 0 {
+			return x.Errorf("A cursor in after can't be used along with offset")
 		}
-		args.AfterUID = uint64(after)
 	}
 
 	if v, ok := gq.Args["depth"]; ok && (args.Alias == "shortest") {
@@ -819,6 +894,29 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 		}
 		args.Count = int(first)
 	}
+	if v, ok := gq.Args["random"]; ok {
+		random, err := strconv.ParseUint(v, 0, 32)
+		if err != nil || random == 0 {
+			return x.Errorf("Expected a positive number for random, got: %s", v)
+		}
+		if args.Count != 0 || args.Offset != 0 || args.AfterUID != 0 || args.AfterCursor != nil ||
+			len(args.Order) > 0 {
+			return x.Errorf("random can't be used along with first, offset, after or ordering")
+		}
+		args.Random = int(random)
+		// Without a seed, every query gets a different sample.
+		args.Seed = time.Now().UnixNano()
+	}
+	if v, ok := gq.Args["seed"]; ok {
+		if args.Random == 0 {
+			return x.Errorf("seed can only be used along with random")
+		}
+		seed, err := strconv.ParseInt(v, 0, 64)
+		if err != nil {
+			return x.Errorf("Expected a number for seed, got: %s", v)
+		}
+		args.Seed = seed
+	}
 	return nil
 }
 
@@ -870,8 +968,13 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 		ParentVars:    make(map[string]varValue),
 		Normalize:     gq.Normalize,
 		Cascade:       gq.Cascade,
+		CascadeFields: gq.CascadeFields,
 		isGroupBy:     gq.IsGroupby,
 		groupbyAttrs:  gq.GroupbyAttrs,
+		groupbyOrder:  gq.GroupbyOrder,
+		groupbyFirst:  gq.GroupbyFirst,
+		groupbyOffset: gq.GroupbyOffset,
+		having:        gq.Having,
 		uidCount:      gq.UidCount,
 		uidCountAlias: gq.UidCountAlias,
 		IgnoreReflex:  gq.IgnoreReflex,
@@ -905,6 +1008,9 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 		if !isValidFuncName(gq.Func.Name) {
 			return nil, x.Errorf("Invalid function name : %s", gq.Func.Name)
 		}
+		if gq.Func.IsLenVar {
+			return nil, x.Errorf("len() can only be used in the condition of an upsert mutation")
+		}
 		sg.createSrcFunction(gq.Func)
 	}
 
@@ -999,9 +1105,52 @@ func createTaskQuery(sg *SubGraph) (*intern.Query, error) {
 	if sg.SrcUIDs != nil {
 		out.UidList = sg.SrcUIDs
 	}
+	if sg.canStopEarly() {
+		out.First = uint32(sg.Params.Count + sg.Params.Offset)
+	}
+	if sg.canSampleInWorker() {
+		out.Random = uint32(sg.Params.Random)
+		out.Seed = sg.Params.Seed
+	}
+	out.FuncVals = sg.SrcUIDs == nil && sg.returnsFuncVals()
 	return out, nil
 }
 
+// canSampleInWorker tells whether the uid lists of the task can be sampled by the worker, before
+// they're sent back. The ones still to be filtered, or which come with facets or the values
+// of the function at root, are sampled once they're back.
+func (sg *SubGraph) canSampleInWorker() bool {
+	return sg.Params.Random > 0 && len(sg.Filters) == 0 && sg.Params.Facet == nil &&
+		sg.facetsFilter == nil && !sg.Params.DoCount && !sg.returnsFuncVals()
+}
+
+// canStopEarly tells whether the function at root can stop looking for matches once it has
+// found as many as the first ones asked for. prefix finds the matches in the order of the index
+// keys, which for the exact index is also the order of the values.
+func (sg *SubGraph) canStopEarly() bool {
+	if sg.SrcFunc == nil || sg.SrcFunc.Name != "prefix" || sg.SrcUIDs != nil ||
+		len(sg.Filters) > 0 || sg.Params.Count <= 0 || sg.Params.AfterUID > 0 ||
+		sg.Params.AfterCursor != nil || sg.Params.Cascade {
+		return false
+	}
+	switch len(sg.Params.Order) {
+	case 0:
+		return true
+	case 1:
+		order := sg.Params.Order[0]
+		if order.Attr != sg.Attr || order.Desc || len(order.Langs) > 0 ||
+			order.Aggregate != "" || order.Nulls != "" || !schema.State().IsIndexed(sg.Attr) {
+			return false
+		}
+		for _, name := range schema.State().TokenizerNames(sg.Attr) {
+			if name == "exact" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 type varValue struct {
 	Uids *intern.List
 	Vals map[uint64]types.Val
@@ -1027,9 +1176,7 @@ func evalLevelAgg(doneVars map[string]varValue, sg, parent *SubGraph) (mp map[ui
 			return mp, nil
 		}
 
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
-		}
+		ag := newAggregator(sg.SrcFunc)
 		for _, val := range vals {
 			ag.Apply(val)
 		}
@@ -1070,9 +1217,7 @@ func evalLevelAgg(doneVars map[string]varValue, sg, parent *SubGraph) (mp map[ui
 	mp = make(map[uint64]types.Val)
 	// Go over the sibling node and aggregate.
 	for i, list := range relSG.uidMatrix {
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
-		}
+		ag := newAggregator(sg.SrcFunc)
 		for _, uid := range list.Uids {
 			if val, ok := vals[uid]; ok {
 				ag.Apply(val)
@@ -1303,6 +1448,25 @@ func (sg *SubGraph) updateFacetMatrix() {
 	}
 }
 
+// cascadesAll returns whether the @cascade of the block applies to every level below it. The
+// one restricted to some fields applies to the block only.
+func (p *params) cascadesAll() bool {
+	return p.Cascade && len(p.CascadeFields) == 0
+}
+
+// isCascadeField returns whether the @cascade of the block requires the child.
+func (p *params) isCascadeField(child *SubGraph) bool {
+	if len(p.CascadeFields) == 0 {
+		return true
+	}
+	for _, f := range p.CascadeFields {
+		if f == child.Attr || (f == child.Params.Alias && f != "") {
+			return true
+		}
+	}
+	return false
+}
+
 func (sg *SubGraph) updateUidMatrix() {
 	sg.updateFacetMatrix()
 	for _, l := range sg.uidMatrix {
@@ -1344,7 +1508,7 @@ func (sg *SubGraph) populateVarMap(doneVars map[string]varValue,
 			return err
 		}
 		sgPath = sgPath[:len(sgPath)-1] // Backtrack
-		if !sg.Params.Cascade {
+		if !sg.Params.Cascade && !child.Params.Cascade {
 			continue
 		}
 
@@ -1363,7 +1527,10 @@ func (sg *SubGraph) populateVarMap(doneVars map[string]varValue,
 		for _, child := range sg.Children {
 			// For uid we dont actually populate the uidMatrix or values. So a node asking for
 			// uid would always be excluded. Therefore we skip it.
-			if child.Attr == "uid" {
+			if child.Attr == "uid" || child.Attr == cursorAttr {
+				continue
+			}
+			if !sg.Params.isCascadeField(child) {
 				continue
 			}
 
@@ -1405,6 +1572,25 @@ func (sg *SubGraph) updateVars(doneVars map[string]varValue, sgPath []*SubGraph)
 	return sg.populateFacetVars(doneVars, sgPathCopy)
 }
 
+// populateFuncVals moves the values returned by the function at root out of the value matrix,
+// so that a variable defined by the block can hold them.
+func (sg *SubGraph) populateFuncVals() {
+	sg.funcVals = make(map[uint64]types.Val)
+	for _, uids := range sg.uidMatrix {
+		for i, uid := range uids.Uids {
+			if i >= len(sg.valueMatrix) || len(sg.valueMatrix[i].Values) == 0 {
+				break
+			}
+			val, err := convertTo(sg.valueMatrix[i].Values[0])
+			if err != nil {
+				continue
+			}
+			sg.funcVals[uid] = val
+		}
+	}
+	sg.valueMatrix = nil
+}
+
 func (sg *SubGraph) populateUidValVar(doneVars map[string]varValue, sgPath []*SubGraph) error {
 	if sg.Params.Var == "" {
 		return nil
@@ -1442,10 +1628,20 @@ func (sg *SubGraph) populateUidValVar(doneVars map[string]varValue, sgPath []*Su
 
 		// This implies it is a entity variable.
 		if v, ok = doneVars[sg.Params.Var]; !ok {
-			doneVars[sg.Params.Var] = varValue{
+			v = varValue{
 				Uids: uids,
 				path: sgPath,
 			}
+			if sg.funcVals != nil {
+				// Some functions at root also make the values they compute available.
+				v.Vals = make(map[uint64]types.Val)
+				for _, uid := range uids.Uids {
+					if d, ok := sg.funcVals[uid]; ok {
+						v.Vals[uid] = d
+					}
+				}
+			}
+			doneVars[sg.Params.Var] = v
 			return nil
 		}
 
@@ -1739,29 +1935,27 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 			continue
 		}
 
-		if !worker.Config.ExpandEdge {
-			return out,
-				x.Errorf("Cannot run expand() query when ExpandEdge(--expand_edge) is false.")
-		}
-
 		var preds []string
-		// It could be expand(_all_) or expand(val(x)).
-		if child.Params.Expand == "_all_" {
-			// Get the predicate list for expansion.
-			child.ExpandPreds, err = getNodePredicates(ctx, sg)
-			if err != nil {
-				return out, err
+		// It could be expand(_all_), expand(val(x)) or expand(Type).
+		switch {
+		case len(child.Params.NeedsVar) > 0:
+			if !worker.Config.ExpandEdge {
+				return out,
+					x.Errorf("Cannot run expand() query when ExpandEdge(--expand_edge) is false.")
 			}
+			// We already have the predicates populated from the var.
 			preds = uniquePreds(child.ExpandPreds)
-
-			rpreds, err := getReversePredicates(ctx)
-			if err != nil {
+		case child.Params.Expand == "_all_":
+			if preds, err = expandAllPreds(ctx, sg, child); err != nil {
 				return out, err
 			}
-			preds = append(preds, rpreds...)
-		} else {
-			// We already have the predicates populated from the var.
-			preds = uniquePreds(child.ExpandPreds)
+		default:
+			typ, ok := schema.State().GetType(child.Params.Expand)
+			if !ok {
+				return out, x.Errorf("Type %s used in expand() is not defined",
+					child.Params.Expand)
+			}
+			preds = typ.Fields
 		}
 
 		for _, pred := range preds {
@@ -1771,7 +1965,7 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 				Attr:    pred,
 			}
 			temp.Params = child.Params
-			temp.Params.expandAll = child.Params.Expand == "_all_"
+			temp.Params.expandAll = len(child.Params.NeedsVar) == 0
 			temp.Params.ParentVars = make(map[string]varValue)
 			for k, v := range child.Params.ParentVars {
 				temp.Params.ParentVars[k] = v
@@ -1798,6 +1992,75 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	return out, nil
 }
 
+// expandAllPreds returns the predicates to fetch for expand(_all_). Nodes which have a type
+// are expanded to the fields of their types. Only the rest are expanded to the predicates in
+// their _predicate_ list, which isn't kept for typed nodes.
+func expandAllPreds(ctx context.Context, sg *SubGraph, child *SubGraph) ([]string, error) {
+	predSet := make(map[string]struct{})
+	untyped := sg.DestUIDs.GetUids()
+	if len(schema.State().Types()) > 0 {
+		typeLists, err := getNodeTypes(ctx, sg)
+		if err != nil {
+			return nil, err
+		}
+		untyped = nil
+		for i, uid := range sg.DestUIDs.GetUids() {
+			var fields []string
+			if i < len(typeLists) {
+				fields = typeFields(typeLists[i])
+			}
+			if len(fields) == 0 {
+				untyped = append(untyped, uid)
+			}
+			for _, field := range fields {
+				predSet[field] = struct{}{}
+			}
+		}
+	}
+
+	if len(untyped) > 0 {
+		if !worker.Config.ExpandEdge {
+			return nil,
+				x.Errorf("Cannot run expand() query when ExpandEdge(--expand_edge) is false.")
+		}
+		// Get the predicate list for expansion.
+		var err error
+		child.ExpandPreds, err = getNodeValues(ctx, sg, x.PredicateListAttr,
+			&intern.List{Uids: untyped})
+		if err != nil {
+			return nil, err
+		}
+		for _, pred := range uniquePreds(child.ExpandPreds) {
+			predSet[pred] = struct{}{}
+		}
+
+		rpreds, err := getReversePredicates(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, pred := range rpreds {
+			predSet[pred] = struct{}{}
+		}
+	}
+
+	preds := make([]string, 0, len(predSet))
+	for pred := range predSet {
+		preds = append(preds, pred)
+	}
+	return preds, nil
+}
+
+// typeFields returns the fields of the defined types among the given types of a node.
+func typeFields(types *intern.ValueList) []string {
+	var fields []string
+	for _, v := range types.Values {
+		if typ, ok := schema.State().GetType(string(v.Val)); ok {
+			fields = append(fields, typ.Fields...)
+		}
+	}
+	return fields
+}
+
 func getReversePredicates(ctx context.Context) ([]string, error) {
 	schs, err := worker.GetSchemaOverNetwork(ctx, &intern.SchemaRequest{})
 	if err != nil {
@@ -1819,7 +2082,7 @@ func getReversePredicates(ctx context.Context) ([]string, error) {
 // ProcessGraph processes the SubGraph instance accumulating result for the query
 // from different instances. Note: taskQuery is nil for root node.
 func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
-	if sg.Attr == "uid" {
+	if sg.Attr == "uid" || sg.Attr == cursorAttr {
 		// We dont need to call ProcessGraph for uid, as we already have uids
 		// populated from parent and there is nothing to process but uidMatrix
 		// and values need to have the right sizes so that preTraverse works.
@@ -1827,6 +2090,14 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		rch <- nil
 		return
 	}
+	if sg.SrcFunc != nil && sg.SrcFunc.Name == "exists" {
+		rch <- sg.evalExists(ctx)
+		return
+	}
+	if parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "terms" {
+		rch <- sg.evalTerms(ctx)
+		return
+	}
 	var err error
 	if parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "uid" {
 		// I'm root and I'm using some variable that has been populated.
@@ -1882,7 +2153,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				rch <- err
 				return
 			}
-			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+			result, err := sg.processTask(ctx, taskQuery)
 			if err != nil {
 				if tr, ok := trace.FromContext(ctx); ok {
 					tr.LazyPrintf("Error while processing task: %+v", err)
@@ -1920,6 +2191,9 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			if parent == nil {
 				// I'm root. We reach here if root had a function.
 				sg.uidMatrix = []*intern.List{sg.DestUIDs}
+				if sg.returnsFuncVals() {
+					sg.populateFuncVals()
+				}
 			}
 		}
 	}
@@ -1943,6 +2217,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 
 	// Run filters if any.
 	if len(sg.Filters) > 0 {
+		filterStart := time.Now()
 		// Run all filters in parallel.
 		filterChan := make(chan error, len(sg.Filters))
 		for _, filter := range sg.Filters {
@@ -2001,6 +2276,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			lists = append(lists, sg.DestUIDs)
 			sg.DestUIDs = algo.IntersectSorted(lists)
 		}
+		sg.stats.filterTime = time.Since(filterStart)
 	}
 
 	if len(sg.Params.Order) == 0 && len(sg.Params.FacetOrder) == 0 {
@@ -2013,10 +2289,21 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		// If we are asked for count, we don't need to change the order of results.
 		if !sg.Params.DoCount {
 			// We need to sort first before pagination.
+			sortStart := time.Now()
 			if err = sg.applyOrderAndPagination(ctx); err != nil {
 				rch <- err
 				return
 			}
+			sg.stats.sortTime = time.Since(sortStart)
+		}
+	}
+	for _, child := range sg.Children {
+		if child.Attr == cursorAttr {
+			if err = sg.fillCursors(ctx); err != nil {
+				rch <- err
+				return
+			}
+			break
 		}
 	}
 
@@ -2055,14 +2342,47 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		// Add the attrs required by groupby nodes
 		for _, it := range sg.Params.groupbyAttrs {
 			// TODO - Throw error if Attr is of list type.
+			path, err := groupbyPath(ctx, it.Attr)
+			if err != nil {
+				rch <- err
+				return
+			}
+			// A multi-hop key is a chain of children, one for each predicate of the path.
+			var child *SubGraph
+			for i := len(path) - 1; i >= 0; i-- {
+				hop := &SubGraph{
+					Attr:    path[i],
+					ReadTs:  sg.ReadTs,
+					LinRead: sg.LinRead,
+					Params: params{
+						ignoreResult: true,
+					},
+				}
+				if child == nil {
+					hop.Params.Langs = it.Langs
+				} else {
+					hop.Children = []*SubGraph{child}
+				}
+				child = hop
+			}
+			child.Params.Alias = it.Alias
+			if child.Params.Alias == "" && len(path) > 1 {
+				child.Params.Alias = it.Attr
+			}
+			sg.Children = append(sg.Children, child)
+		}
+		// Fetch the values of the predicates the groups are filtered or ordered by, if they
+		// aren't aggregated in the block.
+		for _, agg := range sg.groupAggregates() {
+			if agg.Attr == "uid" || sg.groupValueChild(agg) != nil {
+				continue
+			}
 			sg.Children = append(sg.Children, &SubGraph{
-				Attr:    it.Attr,
+				Attr:    agg.Attr,
 				ReadTs:  sg.ReadTs,
 				LinRead: sg.LinRead,
 				Params: params{
-					Alias:        it.Alias,
-					ignoreResult: true,
-					Langs:        it.Langs,
+					Langs: agg.Langs,
 				},
 			})
 		}
@@ -2104,11 +2424,20 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 func (sg *SubGraph) applyPagination(ctx context.Context) error {
 	params := sg.Params
 
-	if params.Count == 0 && params.Offset == 0 { // No pagination.
+	if params.Count == 0 && params.Offset == 0 && params.Random == 0 { // No pagination.
 		return nil
 	}
 
 	sg.updateUidMatrix()
+	if params.Random > 0 {
+		// A no-op for the lists already sampled by the worker.
+		r := rand.New(rand.NewSource(params.Seed))
+		for _, l := range sg.uidMatrix {
+			algo.Sample(l, params.Random, r)
+		}
+		sg.DestUIDs = algo.MergeSorted(sg.uidMatrix)
+		return nil
+	}
 	for i := 0; i < len(sg.uidMatrix); i++ {
 		// Apply the offsets.
 		start, end := x.PageRange(sg.Params.Count, sg.Params.Offset, len(sg.uidMatrix[i].Uids))
@@ -2119,6 +2448,102 @@ func (sg *SubGraph) applyPagination(ctx context.Context) error {
 	return nil
 }
 
+// evalTerms counts the nodes having each of the indexed values of the predicate of terms(),
+// among the ones of its uid variable if it has one. The values and their counts are kept as the
+// groups of a groupby on the predicate, which is how they're output.
+func (sg *SubGraph) evalTerms(ctx context.Context) error {
+	if len(sg.Children) > 0 || len(sg.Filters) > 0 || sg.Params.Count != 0 ||
+		sg.Params.Offset != 0 || len(sg.Params.Order) > 0 || sg.Params.Random != 0 {
+		return x.Errorf("A block with terms() can't have children, filters or pagination." +
+			" Use the first, offset and order arguments of terms() instead")
+	}
+	// The order, its direction, the first and the offset.
+	args := sg.SrcFunc.Args
+	first, err := strconv.Atoi(args[2].Value)
+	if err != nil {
+		return err
+	}
+	offset, err := strconv.Atoi(args[3].Value)
+	if err != nil {
+		return err
+	}
+
+	taskQuery, err := createTaskQuery(sg)
+	if err != nil {
+		return err
+	}
+	taskQuery.SrcFunc.Args = taskQuery.SrcFunc.Args[:2]
+	if len(sg.Params.NeedsVar) > 0 {
+		taskQuery.UidList = sg.DestUIDs
+	}
+	if first > 0 {
+		taskQuery.First = uint32(first + offset)
+	}
+	result, err := sg.processTask(ctx, taskQuery)
+	if err != nil {
+		return err
+	}
+
+	res := &groupResults{}
+	if len(result.ValueMatrix) > 0 {
+		for i, v := range result.ValueMatrix[0].Values {
+			if i < offset {
+				continue
+			}
+			res.group = append(res.group, &groupResult{
+				keys: []groupPair{{
+					attr: sg.Attr,
+					key:  types.Val{Tid: types.StringID, Value: string(v.Val)},
+				}},
+				aggregates: []groupPair{{
+					attr: "count",
+					key:  types.Val{Tid: types.IntID, Value: int64(result.Counts[i])},
+				}},
+			})
+		}
+	}
+	sg.Params.isGroupBy = true
+	sg.GroupbyRes = []*groupResults{res}
+	sg.uidMatrix = []*intern.List{{}}
+	sg.DestUIDs = &intern.List{}
+	return nil
+}
+
+// evalExists keeps the nodes of SrcUIDs with an edge of the predicate of exists to a node
+// matching its filter: a semi-join with the nodes fetched by the child of sg. Under not, the
+// nodes without any are kept instead.
+func (sg *SubGraph) evalExists(ctx context.Context) error {
+	child := sg.Children[0]
+	child.SrcUIDs = sg.SrcUIDs
+	child.Params.ParentVars = sg.Params.ParentVars
+	rch := make(chan error, 1)
+	ProcessGraph(ctx, child, sg, rch)
+	if err := <-rch; err != nil {
+		return err
+	}
+
+	sg.DestUIDs = &intern.List{}
+	for i, uid := range sg.SrcUIDs.Uids {
+		var exists bool
+		if i < len(child.uidMatrix) {
+			for _, dst := range child.uidMatrix[i].Uids {
+				if algo.IndexOf(child.DestUIDs, dst) >= 0 {
+					exists = true
+					break
+				}
+			}
+		}
+		// A predicate with values has no nodes to match a filter.
+		if !exists && len(child.Filters) == 0 && i < len(child.valueMatrix) {
+			exists = len(child.valueMatrix[i].Values) > 0
+		}
+		if exists {
+			sg.DestUIDs.Uids = append(sg.DestUIDs.Uids, uid)
+		}
+	}
+	return nil
+}
+
 // applyOrderAndPagination orders each posting list by a given attribute
 // before applying pagination.
 func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
@@ -2133,13 +2558,12 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 		return sg.sortAndPaginateUsingFacet(ctx)
 	}
 
-	for _, it := range sg.Params.NeedsVar {
-		// TODO(pawan) - Return error if user uses var order with predicates.
-		if len(sg.Params.Order) > 0 && it.Name == sg.Params.Order[0].Attr &&
-			(it.Typ == gql.VALUE_VAR) {
-			// If the Order name is same as var name and it's a value variable, we sort using that variable.
-			return sg.sortAndPaginateUsingVar(ctx)
+	if sg.isVarOrder() {
+		// If the Order name is same as var name and it's a value variable, we sort using that variable.
+		if sg.Params.AfterCursor != nil {
+			return x.Errorf("A cursor in after can't be used to sort by a value variable")
 		}
+		return sg.sortAndPaginateUsingVar(ctx)
 	}
 
 	if sg.Params.Count == 0 {
@@ -2149,15 +2573,20 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 
 	x.AssertTrue(len(sg.Params.Order) > 0)
 
+	order, err := sg.sortOrder(ctx)
+	if err != nil {
+		return err
+	}
 	sort := &intern.SortMessage{
-		Order:     sg.Params.Order,
+		Order:     order,
 		UidMatrix: sg.uidMatrix,
 		Offset:    int32(sg.Params.Offset),
 		Count:     int32(sg.Params.Count),
+		After:     sg.Params.AfterCursor,
 		ReadTs:    sg.ReadTs,
 		LinRead:   sg.LinRead,
 	}
-	result, err := worker.SortOverNetwork(ctx, sort)
+	result, err := sg.processSort(ctx, sort)
 	if err != nil {
 		return err
 	}
@@ -2186,6 +2615,109 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 	return nil
 }
 
+// isVarOrder returns whether sg is sorted by a value variable.
+func (sg *SubGraph) isVarOrder() bool {
+	for _, it := range sg.Params.NeedsVar {
+		// TODO(pawan) - Return error if user uses var order with predicates.
+		if len(sg.Params.Order) > 0 && it.Name == sg.Params.Order[0].Attr &&
+			(it.Typ == gql.VALUE_VAR) {
+			return true
+		}
+	}
+	return false
+}
+
+// cursorAttr is the pseudo predicate returning the cursor of each node of a sorted block. Passed
+// in after, the cursor of the last node of a page gets the next one straight from the index.
+const cursorAttr = "_cursor_"
+
+func encodeCursor(c *intern.SortCursor) (string, error) {
+	b, err := c.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeCursor(s string) (*intern.SortCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	c := new(intern.SortCursor)
+	if err := c.Unmarshal(b); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// fillCursors sets the cursors of the nodes of sg, made of the values they're sorted by.
+func (sg *SubGraph) fillCursors(ctx context.Context) error {
+	if len(sg.Params.Order) == 0 || sg.isVarOrder() {
+		return x.Errorf("%s can only be asked in blocks sorted by predicates", cursorAttr)
+	}
+	order, err := sg.sortOrder(ctx)
+	if err != nil {
+		return err
+	}
+	cursors := make([]*intern.SortCursor, len(sg.DestUIDs.Uids))
+	for i, uid := range sg.DestUIDs.Uids {
+		cursors[i] = &intern.SortCursor{Uid: uid}
+	}
+	for i, o := range order {
+		if len(o.Path) > 0 || o.Aggregate != "" {
+			return x.Errorf("%s can't be asked in blocks sorted by a path of predicates: %s",
+				cursorAttr, o.Attr)
+		}
+		result, err := sg.processTask(ctx, &intern.Query{
+			Attr:    o.Attr,
+			Langs:   o.Langs,
+			UidList: sg.DestUIDs,
+			ReadTs:  sg.ReadTs,
+			LinRead: sg.LinRead,
+		})
+		if err != nil {
+			return err
+		}
+		for j, vl := range result.ValueMatrix {
+			if len(vl.Values) == 0 {
+				cursors[j].Nulls |= 1 << uint(i)
+				cursors[j].Values = append(cursors[j].Values, &intern.TaskValue{})
+				continue
+			}
+			cursors[j].Values = append(cursors[j].Values, vl.Values[0])
+		}
+	}
+	sg.cursors = make(map[uint64]string, len(cursors))
+	for _, c := range cursors {
+		if sg.cursors[c.Uid], err = encodeCursor(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortOrder returns the orders of sg, with their paths like works_at.name split into the
+// predicates leading to the values and the predicate of the values.
+func (sg *SubGraph) sortOrder(ctx context.Context) ([]*intern.Order, error) {
+	order := make([]*intern.Order, 0, len(sg.Params.Order))
+	for _, o := range sg.Params.Order {
+		path, err := groupbyPath(ctx, o.Attr)
+		if err != nil {
+			return nil, err
+		}
+		if len(path) == 1 {
+			order = append(order, o)
+			continue
+		}
+		hops := *o
+		hops.Path = path[:len(path)-1]
+		hops.Attr = path[len(path)-1]
+		order = append(order, &hops)
+	}
+	return order, nil
+}
+
 func (sg *SubGraph) updateDestUids() {
 	// Update sg.destUID. Iterate over the UID matrix (which is not sorted by
 	// UID). For each element in UID matrix, we do a binary search in the
@@ -2265,14 +2797,16 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 		return x.Errorf("Variable: [%s] used before definition.", sg.Params.Order[0].Attr)
 	}
 
+	order := sg.Params.Order[0]
+	nullsFirst := order.Nulls == "first" || (order.Nulls == "" && order.Desc)
 	for i := 0; i < len(sg.uidMatrix); i++ {
 		ul := sg.uidMatrix[i]
 		uids := make([]uint64, 0, len(ul.Uids))
 		values := make([][]types.Val, 0, len(ul.Uids))
 		for _, uid := range ul.Uids {
 			v, ok := sg.Params.uidToVal[uid]
-			if !ok {
-				// We skip the UIDs which don't have a value.
+			if !ok && order.Nulls == "" {
+				// We skip the UIDs which don't have a value, unless told where to put them.
 				continue
 			}
 			values = append(values, []types.Val{v})
@@ -2281,7 +2815,8 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 		if len(values) == 0 {
 			continue
 		}
-		if err := types.Sort(values, &intern.List{uids}, []bool{sg.Params.Order[0].Desc}); err != nil {
+		if err := types.SortWithNulls(values, &intern.List{uids}, []bool{order.Desc},
+			[]bool{nullsFirst}); err != nil {
 			return err
 		}
 		sg.uidMatrix[i].Uids = uids
@@ -2303,17 +2838,35 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 // isValidArg checks if arg passed is valid keyword.
 func isValidArg(a string) bool {
 	switch a {
-	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth":
+	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth",
+		"random", "seed":
 		return true
 	}
 	return false
 }
 
+// returnsFuncVals tells whether the function returns a value for each uid it matches at root:
+// the distance for similar_to and the relevance score for full text search. Scoring isn't free,
+// so the matches of full text search are only scored if the block binds them to a variable.
+func (sg *SubGraph) returnsFuncVals() bool {
+	if sg.SrcFunc == nil {
+		return false
+	}
+	switch sg.SrcFunc.Name {
+	case "similar_to":
+		return true
+	case "anyoftext", "alloftext":
+		return sg.Params.Var != ""
+	}
+	return false
+}
+
 // isValidFuncName checks if fn passed is valid keyword.
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext",
-		"has", "uid", "uid_in", "anyof", "allof":
+		"has", "uid", "uid_in", "anyof", "allof", "match", "similar_to", "phrase",
+		"near_words", "prefix", "terms":
 		return true
 	}
 	return isInequalityFn(f) || types.IsGeoFunc(f)
@@ -2329,7 +2882,8 @@ func isInequalityFn(f string) bool {
 
 func isAggregatorFn(f string) bool {
 	switch f {
-	case "min", "max", "sum", "avg":
+	case "min", "max", "sum", "avg", "count_distinct", "median", "percentile", "stddev",
+		"variance":
 		return true
 	}
 	return false
@@ -2340,16 +2894,27 @@ func isUidFnWithoutVar(f *gql.Function) bool {
 }
 
 func getNodePredicates(ctx context.Context, sg *SubGraph) ([]*intern.ValueList, error) {
+	return getNodeValues(ctx, sg, "_predicate_", sg.DestUIDs)
+}
+
+// getNodeTypes returns the types of the destination uids of the given SubGraph.
+func getNodeTypes(ctx context.Context, sg *SubGraph) ([]*intern.ValueList, error) {
+	return getNodeValues(ctx, sg, x.TypeAttr, sg.DestUIDs)
+}
+
+// getNodeValues returns the values of attr for the given uids, on behalf of the SubGraph.
+func getNodeValues(ctx context.Context, sg *SubGraph, attr string,
+	uids *intern.List) ([]*intern.ValueList, error) {
 	temp := new(SubGraph)
-	temp.Attr = "_predicate_"
-	temp.SrcUIDs = sg.DestUIDs
+	temp.Attr = attr
+	temp.SrcUIDs = uids
 	temp.ReadTs = sg.ReadTs
 	temp.LinRead = sg.LinRead
 	taskQuery, err := createTaskQuery(temp)
 	if err != nil {
 		return nil, err
 	}
-	result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+	result, err := sg.processTask(ctx, taskQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -2375,11 +2940,24 @@ func (sg *SubGraph) getAllPredicates(predicates map[string]bool) {
 	if len(sg.Params.Order) != 0 {
 		for _, o := range sg.Params.Order {
 			predicates[o.Attr] = true
+			// It might be a path of predicates.
+			for _, attr := range strings.Split(o.Attr, ".") {
+				predicates[strings.TrimPrefix(attr, "~")] = true
+			}
 		}
 	}
 	if len(sg.Params.groupbyAttrs) != 0 {
 		for _, pred := range sg.Params.groupbyAttrs {
 			predicates[pred.Attr] = true
+			// It might be a multi-hop key.
+			for _, attr := range strings.Split(pred.Attr, ".") {
+				predicates[attr] = true
+			}
+		}
+	}
+	for _, agg := range sg.groupAggregates() {
+		if agg.Attr != "uid" {
+			predicates[agg.Attr] = true
 		}
 	}
 
@@ -2413,14 +2991,83 @@ type QueryRequest struct {
 	vars map[string]varValue
 
 	LinRead *api.LinRead
+
+	// Timeout and MemoryBudget limit how long the query may run and how many bytes the results
+	// of its tasks may take. Zero if there's no limit.
+	Timeout      time.Duration
+	MemoryBudget uint64
 }
 
 // ProcessQuery processes query part of the request (without mutations).
 // Fills Subgraphs and Vars.
-// It optionally also returns a map of the allocated uids in case of an upsert request.
-func (req *QueryRequest) ProcessQuery(ctx context.Context) (err error) {
+// The query is cancelled once it runs out of time or memory.
+func (req *QueryRequest) ProcessQuery(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if req.Timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, req.Timeout)
+		defer cancelTimeout()
+	}
+	var budget *memoryBudget
+	if req.MemoryBudget > 0 {
+		budget = &memoryBudget{limit: req.MemoryBudget, cancel: cancel}
+		ctx = context.WithValue(ctx, budgetKey{}, budget)
+	}
+
+	err := req.processQuery(ctx)
+	switch {
+	case err == nil:
+		return nil
+	case budget.exceeded():
+		return budget.err()
+	case req.Timeout > 0 && ctx.Err() == context.DeadlineExceeded:
+		return x.Errorf("Query was cancelled after running for longer than its timeout of %v",
+			req.Timeout)
+	}
+	return err
+}
+
+// pruneSkipped removes the blocks left out by @include or @skip from the query, before any
+// SubGraph is built for them. The variables defined in skipped blocks are empty.
+func (req *QueryRequest) pruneSkipped() {
+	var emptyVars func(gq *gql.GraphQuery, skipped bool)
+	emptyVars = func(gq *gql.GraphQuery, skipped bool) {
+		skipped = skipped || gq.Skipped()
+		if skipped {
+			if gq.Var != "" {
+				req.vars[gq.Var] = varValue{Vals: make(map[uint64]types.Val)}
+			}
+			for _, v := range gq.FacetVar {
+				req.vars[v] = varValue{Vals: make(map[uint64]types.Val)}
+			}
+		}
+		for _, child := range gq.Children {
+			emptyVars(child, skipped)
+		}
+	}
+
+	queries := req.GqlQuery.Query[:0]
+	queryVars := req.GqlQuery.QueryVars[:0]
+	for i, gq := range req.GqlQuery.Query {
+		if gq == nil {
+			queries = append(queries, gq)
+			queryVars = append(queryVars, req.GqlQuery.QueryVars[i])
+			continue
+		}
+		emptyVars(gq, false)
+		if !gq.Skipped() {
+			queries = append(queries, gq)
+			queryVars = append(queryVars, req.GqlQuery.QueryVars[i])
+		}
+	}
+	req.GqlQuery.Query, req.GqlQuery.QueryVars = queries, queryVars
+}
+
+func (req *QueryRequest) processQuery(ctx context.Context) (err error) {
 	// doneVars stores the processed variables.
 	req.vars = make(map[string]varValue)
+	req.pruneSkipped()
 	loopStart := time.Now()
 	queries := req.GqlQuery.Query
 	for i := 0; i < len(queries); i++ {
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
 	"os"
 	"os/exec"
 	"reflect"
@@ -710,6 +711,48 @@ func TestCascadeDirective(t *testing.T) {
 		js)
 }
 
+func TestCascadeFields(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				name
+				friend @cascade(friend) {
+					name
+					friend {
+						name
+					}
+				}
+			}
+		}
+	`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","friend":[{"name":"Rick Grimes","friend":[{"name":"Michonne"}]},{"name":"Andrea","friend":[{"name":"Glenn Rhee"}]}]}]}}`,
+		js)
+}
+
+func TestCascadeFieldsNormalize(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) @normalize {
+				n: name
+				friend @cascade(name) {
+					f: name
+					friend {
+						ff: name
+					}
+				}
+			}
+		}
+	`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"n":"Michonne","f":"Rick Grimes","ff":"Michonne"},{"n":"Michonne","f":"Glenn Rhee"},{"n":"Michonne","f":"Daryl Dixon"},{"n":"Michonne","f":"Andrea","ff":"Glenn Rhee"}]}}`,
+		js)
+}
+
 func TestLevelBasedFacetVarAggSum(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -1128,6 +1171,38 @@ func TestQueryVarValAggMinMax(t *testing.T) {
 		js)
 }
 
+func TestAggregatorDistribution(t *testing.T) {
+	ints := []types.Val{
+		{Tid: types.IntID, Value: int64(15)},
+		{Tid: types.IntID, Value: int64(19)},
+		{Tid: types.IntID, Value: int64(15)},
+		{Tid: types.IntID, Value: int64(38)},
+	}
+	results := map[string]types.Val{
+		"count_distinct": {Tid: types.IntID, Value: int64(3)},
+		"median":         {Tid: types.FloatID, Value: 17.0},
+		"variance":       {Tid: types.FloatID, Value: 90.6875},
+		"stddev":         {Tid: types.FloatID, Value: math.Sqrt(90.6875)},
+	}
+	for name, res := range results {
+		ag := newAggregator(&Function{Name: name})
+		for _, v := range ints {
+			ag.Apply(v)
+		}
+		v, err := ag.Value()
+		require.NoError(t, err)
+		require.Equal(t, res, v, name)
+	}
+
+	ag := newAggregator(&Function{Name: "percentile", Args: []gql.Arg{{Value: "0.75"}}})
+	for _, sec := range []int64{0, 100, 200, 300, 400} {
+		ag.Apply(types.Val{Tid: types.DateTimeID, Value: time.Unix(sec, 0)})
+	}
+	v, err := ag.Value()
+	require.NoError(t, err)
+	require.Equal(t, types.Val{Tid: types.DateTimeID, Value: time.Unix(300, 0).UTC()}, v)
+}
+
 func TestQueryVarValAggMinMaxAlias(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -1434,6 +1509,131 @@ func TestGroupBy(t *testing.T) {
 		js)
 }
 
+func TestGroupByPath(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(friend.name) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"count":1,"friend.name":"Glenn Rhee"},{"count":1,"friend.name":"Michonne"}]}]}]}}`,
+		js)
+}
+
+func TestGroupByHaving(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(age) @having(gt(count(uid), 1)) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"age":15,"count":2}]}]}]}}`,
+		js)
+}
+
+func TestGroupByOrderFirst(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(age, orderdesc: count(uid), first: 2) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"age":15,"count":2},{"age":17,"count":1}]}]}]}}`,
+		js)
+}
+
+func TestGroupByHavingAvg(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			all(func: uid(1)) {
+				friend @groupby(school) @having(gt(avg(age), 10)) {
+					count(uid)
+				}
+			}
+			older(func: uid(1)) {
+				friend @groupby(school) @having(gt(avg(age), 16.5)) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"all":[{"friend":[{"@groupby":[{"school":"0x1388","count":2},{"school":"0x1389","count":3}]}]}],"older":[{"friend":[{"@groupby":[{"school":"0x1389","count":3}]}]}]}}`,
+		js)
+}
+
+func TestGroupByOrderSum(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(school, orderdesc: sum(age)) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"school":"0x1389","count":3},{"school":"0x1388","count":2}]}]}]}}`,
+		js)
+}
+
+func TestGroupByPathVar(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			var(func: uid(1)) {
+				friend @groupby(friend.school) {
+					s as count(uid)
+				}
+			}
+
+			me(func: uid(s)) {
+				name
+				val(s)
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"School A","val(s)":2}]}}`, js)
+}
+
+func TestHavingWithoutGroupBy(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @having(gt(count(uid), 1)) {
+					name
+				}
+			}
+		}
+	`
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+}
+
 func TestGroupByCountval(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -3093,6 +3293,170 @@ func TestPasswordExpandError(t *testing.T) {
 	require.Contains(t, err.Error(), "Repeated subgraph: [password]")
 }
 
+func TestExpandType(t *testing.T) {
+	populateGraph(t)
+	schema.State().SetType(intern.TypeUpdate{TypeName: "Person", Fields: []string{"name", "age"}})
+	defer schema.State().DeleteType("Person")
+	query := `
+    {
+        me(func: uid(0x01)) {
+			expand(Person)
+		}
+    }
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","age":38}]}}`, js)
+}
+
+func TestExpandTypeUndefined(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+        me(func: uid(0x01)) {
+			expand(Animal)
+		}
+    }
+	`
+	_, err := processToFastJson(t, query)
+	require.Contains(t, err.Error(), "Type Animal used in expand() is not defined")
+}
+
+func TestExpandAllTyped(t *testing.T) {
+	populateGraph(t)
+	schema.State().SetType(intern.TypeUpdate{TypeName: "Person", Fields: []string{"name", "age"}})
+	defer schema.State().DeleteType("Person")
+	// Typed nodes have no _predicate_ list, so _type_ is set without it.
+	addEdgeToLangValue(t, x.TypeAttr, 1, "Person", "", nil)
+	defer delEdgeToLangValue(t, x.TypeAttr, 1, "Person", "")
+	query := `
+    {
+        me(func: uid(0x01, 23)) {
+			expand(_all_) {
+				uid
+			}
+		}
+    }
+	`
+	// 0x1 is expanded to the fields of Person, 23 to its _predicate_ list.
+	js := processToFastJsonNoErr(t, query)
+	require.Contains(t, js, `{"name":"Michonne","age":38}`)
+	require.Contains(t, js, `"name":"Rick Grimes"`)
+	require.Contains(t, js, `"friend":[{"uid":"0x1"}]`)
+}
+
+func TestExpandEdgesTyped(t *testing.T) {
+	populateGraph(t)
+	schema.State().SetType(intern.TypeUpdate{TypeName: "Person", Fields: []string{"name", "age"}})
+	defer schema.State().DeleteType("Person")
+	edges, err := expandEdges(defaultContext(), &intern.Mutations{
+		StartTs: timestamp(),
+		Edges: []*intern.DirectedEdge{
+			{Entity: 2400, Attr: x.TypeAttr, Value: []byte("Person"), Op: intern.DirectedEdge_SET},
+			{Entity: 2400, Attr: "name", Value: []byte("Jane"), Op: intern.DirectedEdge_SET},
+			{Entity: 2401, Attr: "name", Value: []byte("John"), Op: intern.DirectedEdge_SET},
+		},
+	})
+	require.NoError(t, err)
+	// Only the untyped node gets a _predicate_ edge.
+	var predEntities []uint64
+	for _, edge := range edges {
+		if edge.Attr == x.PredicateListAttr {
+			predEntities = append(predEntities, edge.Entity)
+		}
+	}
+	require.Equal(t, 4, len(edges))
+	require.Equal(t, []uint64{2401}, predEntities)
+}
+
+func TestTypeFunction(t *testing.T) {
+	populateGraph(t)
+	addEdgeToLangValue(t, x.TypeAttr, 2300, "Person", "", nil)
+	addEdgeToLangValue(t, x.TypeAttr, 2333, "Person", "", nil)
+	defer delEdgeToLangValue(t, x.TypeAttr, 2300, "Person", "")
+	defer delEdgeToLangValue(t, x.TypeAttr, 2333, "Person", "")
+	query := `
+    {
+        me(func: type(Person)) {
+			name
+		}
+    }
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Andre"},{"name":"Helmut"}]}}`, js)
+}
+
+func addEmbeddings(t *testing.T) {
+	addEdgeToValue(t, "embedding", 1, "[1, 0, 0]", nil)
+	addEdgeToValue(t, "embedding", 23, "[0.9, 0.1, 0]", nil)
+	addEdgeToValue(t, "embedding", 24, "[0, 1, 0]", nil)
+	addEdgeToValue(t, "embedding", 25, "[0, 0, 1]", nil)
+	addEdgeToValue(t, "embedding", 31, "[-1, 0, 0]", nil)
+}
+
+func TestSimilarTo(t *testing.T) {
+	populateGraph(t)
+	addEmbeddings(t)
+	query := `
+    {
+        me(func: similar_to(embedding, 2, "[1, 0.05, 0]")) {
+			name
+			embedding
+		}
+    }
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne","embedding":[1, 0, 0]},
+		{"name":"Rick Grimes","embedding":[0.9, 0.1, 0]}]}}`, js)
+}
+
+func TestSimilarToDistanceVar(t *testing.T) {
+	populateGraph(t)
+	addEmbeddings(t)
+	query := `
+    {
+        d as var(func: similar_to(embedding, 3, "[1, 0.05, 0]"))
+
+        me(func: uid(d), orderdesc: val(d)) {
+			name
+		}
+    }
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Glenn Rhee"},{"name":"Rick Grimes"},
+		{"name":"Michonne"}]}}`, js)
+}
+
+func TestSimilarToFilter(t *testing.T) {
+	populateGraph(t)
+	addEmbeddings(t)
+	query := `
+    {
+        me(func: uid(0x01)) {
+			name
+			friend @filter(similar_to(embedding, 1, "[0, 1, 0.1]")) {
+				name
+			}
+		}
+    }
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne","friend":[{"name":"Glenn Rhee"}]}]}}`, js)
+}
+
+func TestSimilarToWrongType(t *testing.T) {
+	populateGraph(t)
+	query := `
+    {
+        me(func: similar_to(name, 2, "[1, 0.05, 0]")) {
+			name
+		}
+    }
+	`
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+}
+
 func TestCheckPassword(t *testing.T) {
 	populateGraph(t)
 	addPassword(t, 1, "password", "123456")
@@ -3392,85 +3756,411 @@ func TestToFastJSONFilter(t *testing.T) {
 			}
 		}
 	`
-
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne","gender":"female","friend":[{"name":"Andrea"}]}]}}`,
+		js)
+}
+
+func TestToFastJSONFilterMissBrac(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				name
+				gender
+				friend @filter(anyofterms(name, "Andrea SomethingElse") {
+					name
+				}
+			}
+		}
+	`
+	_, err := gql.Parse(gql.Request{Str: query})
+	require.Error(t, err)
+}
+
+func TestToFastJSONFilterallofterms(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				name
+				gender
+				friend @filter(allofterms(name, "Andrea SomethingElse")) {
+					name
+				}
+			}
+		}
+	`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne","gender":"female"}]}}`, js)
+}
+
+func TestInvalidStringIndex(t *testing.T) {
+	// no FTS index defined for name
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				name
+				gender
+				friend @filter(alloftext(name, "Andrea SomethingElse")) {
+					name
+				}
+			}
+		}
+	`
+
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+}
+
+func TestValidFulltextIndex(t *testing.T) {
+	// no FTS index defined for name
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				name
+				friend @filter(alloftext(alias, "BOB")) {
+					alias
+				}
+			}
+		}
+	`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne", "friend":[{"alias":"Bob Joe"}]}]}}`, js)
+}
+
+func TestFullTextScore(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			score as var(func: anyoftext(alias, "john oliver"))
+
+			me(func: uid(score), orderdesc: val(score)) {
+				alias
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"alias":"John Oliver"},{"alias":"John Alice"}]}}`, js)
+}
+
+func TestFullTextScoreValue(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			score as var(func: anyoftext(alias, "john oliver"))
+
+			me(func: uid(score), orderdesc: val(score)) {
+				alias
+				val(score)
+			}
+		}
+	`
+	// All the five aliases are two words long, so the scores are the sums of the inverse
+	// document frequencies of the words matched: ln(1 + 3.5/2.5) for john and ln(1 + 4.5/1.5)
+	// for oliver.
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[
+		{"alias":"John Oliver","val(score)":2.261763},
+		{"alias":"John Alice","val(score)":0.875469}]}}`, js)
+}
+
+func TestHighlight(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: anyoftext(alias, "johns alices")) {
+				alias @highlight
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[
+		{"alias":"Zambo <em>Alice</em>"},
+		{"alias":"<em>John</em> <em>Alice</em>"},
+		{"alias":"<em>John</em> Oliver"}]}}`, js)
+}
+
+func TestHighlightFilter(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @filter(anyofterms(alias, "bob") or regexp(alias, /^Zam/)) {
+					hl: alias @highlight
+					alias
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[
+		{"hl":"<em>Zam</em>bo Alice","alias":"Zambo Alice"},
+		{"hl":"<em>Bob</em> Joe","alias":"Bob Joe"}]}]}}`, js)
+}
+
+func TestHighlightNot(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: anyofterms(alias, "john")) @filter(not anyofterms(alias, "alice")) {
+				alias @highlight
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"alias":"<em>John</em> Oliver"}]}}`, js)
+}
+
+func TestHighlightWithChildren(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @highlight {
+					alias
+				}
+			}
+		}
+	`
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+}
+
+func TestPrefix(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: prefix(name, "Andre")) {
+				name
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Andrea"},{"name":"Andrea With no friends"},
+		{"name":"Andre"}]}}`, js)
+}
+
+func TestPrefixFirst(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: prefix(name, "Andre"), first: 2, orderasc: name) {
+				name
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Andre"},{"name":"Andrea"}]}}`, js)
+}
+
+func TestPrefixFilter(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @filter(prefix(name, "Andre")) {
+					name
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"name":"Andrea"}]}]}}`, js)
+}
+
+func TestExplain(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @filter(anyofterms(name, "Andrea Rick")) (orderasc: name) {
+					name
+				}
+			}
+		}
+	`
+	res, err := gql.Parse(gql.Request{Str: query})
+	require.NoError(t, err)
+	startTs := timestamp()
+	maxPendingCh <- startTs
+	queryRequest := QueryRequest{Latency: &Latency{}, GqlQuery: &res, ReadTs: startTs}
+	require.NoError(t, queryRequest.ProcessQuery(defaultContext()))
+
+	nodes := Explain(queryRequest.Subgraphs)
+	require.Equal(t, 1, len(nodes))
+	me := nodes[0]
+	require.Equal(t, "uid", me.Func)
+	require.Equal(t, 1, me.UidsOut)
+	require.Equal(t, 1, len(me.Children))
+
+	friend := me.Children[0]
+	require.Equal(t, "friend", friend.Attr)
+	require.Equal(t, 1, friend.UidsIn)
+	require.Equal(t, 2, friend.UidsOut)
+	// One task to fetch the friends and one to sort them.
+	require.Equal(t, 2, friend.Tasks)
+	require.Equal(t, []uint32{1}, friend.Groups)
+	require.True(t, friend.Bytes > 0)
+	require.True(t, friend.SortNs > 0)
+	require.True(t, friend.FilterNs > 0)
+
+	require.Equal(t, 1, len(friend.Filters))
+	filter := friend.Filters[0]
+	require.Equal(t, "anyofterms", filter.Func)
+	require.Equal(t, 5, filter.UidsIn)
+	require.Equal(t, 1, filter.Tasks)
+	require.Equal(t, uint64(2), filter.IndexKeys)
+
+	require.Equal(t, 1, len(friend.Children))
+	require.Equal(t, "name", friend.Children[0].Attr)
+	require.Equal(t, 2, friend.Children[0].UidsIn)
+	require.Equal(t, 1, friend.Children[0].Tasks)
+}
+
+func TestQueryMemoryBudget(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend {
+					name
+				}
+			}
+		}
+	`
+	res, err := gql.Parse(gql.Request{Str: query})
+	require.NoError(t, err)
+	startTs := timestamp()
+	maxPendingCh <- startTs
+	queryRequest := QueryRequest{Latency: &Latency{}, GqlQuery: &res, ReadTs: startTs,
+		MemoryBudget: 50}
+	err = queryRequest.ProcessQuery(defaultContext())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "memory budget")
+
+	queryRequest = QueryRequest{Latency: &Latency{}, GqlQuery: &res, ReadTs: startTs,
+		MemoryBudget: 1 << 20}
+	require.NoError(t, queryRequest.ProcessQuery(defaultContext()))
+}
+
+func TestQueryTimeout(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(1)) {
+				friend {
+					name
+				}
+			}
+		}
+	`
+	res, err := gql.Parse(gql.Request{Str: query})
+	require.NoError(t, err)
+	startTs := timestamp()
+	maxPendingCh <- startTs
+	queryRequest := QueryRequest{Latency: &Latency{}, GqlQuery: &res, ReadTs: startTs,
+		Timeout: time.Nanosecond}
+	err = queryRequest.ProcessQuery(defaultContext())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "timeout of 1ns")
+}
+
+func TestPhrase(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: phrase(alias, "john alice")) {
+				alias
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"alias":"John Alice"}]}}`, js)
+}
+
+func TestPhraseWrongOrder(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: phrase(alias, "alice john")) {
+				alias
+			}
+		}
+	`
 	js := processToFastJsonNoErr(t, query)
-	require.JSONEq(t,
-		`{"data": {"me":[{"name":"Michonne","gender":"female","friend":[{"name":"Andrea"}]}]}}`,
-		js)
+	require.JSONEq(t, `{"data": {"me":[]}}`, js)
 }
 
-func TestToFastJSONFilterMissBrac(t *testing.T) {
+func TestPhraseFilter(t *testing.T) {
 	populateGraph(t)
 	query := `
 		{
 			me(func: uid(0x01)) {
 				name
-				gender
-				friend @filter(anyofterms(name, "Andrea SomethingElse") {
-					name
+				friend @filter(phrase(alias, "Bob Joe")) {
+					alias
 				}
 			}
 		}
 	`
-	_, err := gql.Parse(gql.Request{Str: query})
-	require.Error(t, err)
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne", "friend":[{"alias":"Bob Joe"}]}]}}`, js)
 }
 
-func TestToFastJSONFilterallofterms(t *testing.T) {
+func TestPhraseLang(t *testing.T) {
 	populateGraph(t)
 	query := `
 		{
-			me(func: uid(0x01)) {
-				name
-				gender
-				friend @filter(allofterms(name, "Andrea SomethingElse")) {
-					name
-				}
+			en(func: phrase(royal_title@en, "grace of god")) {
+				uid
+			}
+			fr(func: phrase(royal_title@fr, "grâce de Dieu")) {
+				uid
+			}
+			none(func: phrase(royal_title@fr, "grace of god")) {
+				uid
 			}
 		}
 	`
-
 	js := processToFastJsonNoErr(t, query)
 	require.JSONEq(t,
-		`{"data": {"me":[{"name":"Michonne","gender":"female"}]}}`, js)
+		`{"data": {"en":[{"uid":"0x10000"}], "fr":[{"uid":"0x10000"}], "none":[]}}`, js)
 }
 
-func TestInvalidStringIndex(t *testing.T) {
-	// no FTS index defined for name
+func TestNearWords(t *testing.T) {
 	populateGraph(t)
 	query := `
 		{
-			me(func: uid(0x01)) {
-				name
-				gender
-				friend @filter(alloftext(name, "Andrea SomethingElse")) {
-					name
-				}
+			me(func: near_words(alias, "alice john", 1)) {
+				alias
 			}
 		}
 	`
-
-	_, err := processToFastJson(t, query)
-	require.Error(t, err)
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"alias":"John Alice"}]}}`, js)
 }
 
-func TestValidFulltextIndex(t *testing.T) {
-	// no FTS index defined for name
+func TestNearWordsInvalidDistance(t *testing.T) {
 	populateGraph(t)
 	query := `
 		{
-			me(func: uid(0x01)) {
-				name
-				friend @filter(alloftext(alias, "BOB")) {
-					alias
-				}
+			me(func: near_words(alias, "alice john", -1)) {
+				alias
 			}
 		}
 	`
-
-	js := processToFastJsonNoErr(t, query)
-	require.JSONEq(t,
-		`{"data": {"me":[{"name":"Michonne", "friend":[{"alias":"Bob Joe"}]}]}}`, js)
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
 }
 
 // dob (date of birth) is not a string
@@ -3768,6 +4458,65 @@ func TestFilterRegex16(t *testing.T) {
 		js)
 }
 
+func TestFilterMatch(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				name
+				friend @filter(match(name, "Rick Grimez", 1)) {
+					name
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"name":"Michonne", "friend":[{"name":"Rick Grimes"}]}]}}`, js)
+}
+
+func TestFilterMatchDistance(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				name
+				friend @filter(match(name, "Rik Grimez", 1)) {
+					name
+				}
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"}]}}`, js)
+}
+
+func TestMatchAtRootLang(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: match(name@ru, "Барсуг", 1)) {
+				name@ru
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name@ru":"Барсук"}]}}`, js)
+}
+
+func TestMatchShortText(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: match(name, "Ri", 1)) {
+				name
+			}
+		}
+	`
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+}
+
 func TestToFastJSONFilterUID(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -4239,6 +4988,53 @@ func TestToFastJSONFirstOffset(t *testing.T) {
 		js)
 }
 
+func TestToFastJSONRandom(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				friend(random: 2, seed: 7) {
+					uid
+				}
+			}
+		}
+	`
+
+	var res struct {
+		Data struct {
+			Me []struct {
+				Friend []struct {
+					Uid string `json:"uid"`
+				} `json:"friend"`
+			} `json:"me"`
+		} `json:"data"`
+	}
+	js := processToFastJsonNoErr(t, query)
+	require.NoError(t, json.Unmarshal([]byte(js), &res))
+	require.Equal(t, 1, len(res.Data.Me))
+	require.Equal(t, 2, len(res.Data.Me[0].Friend))
+
+	// The same seed gives the same sample.
+	require.Equal(t, js, processToFastJsonNoErr(t, query))
+}
+
+func TestToFastJSONRandomWithFirst(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			me(func: uid(0x01)) {
+				friend(random: 2, first: 1) {
+					name
+				}
+			}
+		}
+	`
+
+	_, err := processToFastJson(t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "random can't be used along with first")
+}
+
 func TestToFastJSONFilterOrFirstOffset(t *testing.T) {
 	populateGraph(t)
 	query := `
@@ -5970,6 +6766,7 @@ func TestSchemaBlock1(t *testing.T) {
 		{Predicate: "symbol", Type: "string"},
 		{Predicate: "room", Type: "string"},
 		{Predicate: "office.room", Type: "uid"},
+		{Predicate: "embedding", Type: "float32vector"},
 	}
 	checkSchemaNodes(t, expected, actual)
 }
@@ -6078,6 +6875,7 @@ password                       : password .
 symbol                         : string @index(exact) .
 room                           : string @index(term) .
 office.room                    : uid .
+embedding                      : float32vector @index(vector) .
 `
 
 // Duplicate implemention as in cmd/dgraph/main_test.go
@@ -7857,6 +8655,160 @@ func TestMultiSort7Paginate(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"name":"Alice","age":25},{"name":"Alice","age":75},{"name":"Alice","age":75},{"name":"Bob","age":25},{"name":"Bob","age":75},{"name":"Colin","age":25},{"name":"Elizabeth","age":25}]}}`, js)
 }
 
+func TestSortByPath(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: uid(1)) {
+			friend(orderdesc: friend.age, nulls: last, first: 2) {
+				name
+			}
+		}
+	}`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"name":"Rick Grimes"},{"name":"Andrea"}]}]}}`, js)
+}
+
+func TestSortByMinOfPath(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: uid(23, 31), orderasc: min(friend.age)) {
+			name
+		}
+	}`
+
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Andrea"},{"name":"Rick Grimes"}]}}`, js)
+}
+
+func TestSortCursor(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: uid(1)) {
+			friend(orderasc: name, first: 2) {
+				name
+				_cursor_
+			}
+		}
+	}`
+	var res struct {
+		Data struct {
+			Me []struct {
+				Friend []struct {
+					Name   string `json:"name"`
+					Cursor string `json:"_cursor_"`
+				} `json:"friend"`
+			} `json:"me"`
+		} `json:"data"`
+	}
+	js := processToFastJsonNoErr(t, query)
+	require.NoError(t, json.Unmarshal([]byte(js), &res))
+	friends := res.Data.Me[0].Friend
+	require.Equal(t, 2, len(friends))
+	require.Equal(t, "Daryl Dixon", friends[1].Name)
+
+	query = fmt.Sprintf(`{
+		me(func: uid(1)) {
+			friend(orderasc: name, first: 2, after: "%s") {
+				name
+			}
+		}
+	}`, friends[1].Cursor)
+	js = processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"name":"Glenn Rhee"},{"name":"Rick Grimes"}]}]}}`, js)
+}
+
+func TestSortCursorWithOffset(t *testing.T) {
+	populateGraph(t)
+
+	c, err := encodeCursor(&intern.SortCursor{
+		Values: []*intern.TaskValue{{Val: []byte("Andrea"), ValType: intern.Posting_STRING}},
+		Uid:    31,
+	})
+	require.NoError(t, err)
+	dc, err := decodeCursor(c)
+	require.NoError(t, err)
+	require.Equal(t, uint64(31), dc.Uid)
+
+	query := fmt.Sprintf(`{
+		me(func: uid(1)) {
+			friend(orderasc: name, offset: 1, after: "%s") {
+				name
+			}
+		}
+	}`, c)
+	_, err = processToFastJson(t, query)
+	require.Error(t, err)
+}
+
+func TestFilterExists(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: uid(1, 23, 24, 31)) @filter(exists(friend @filter(eq(name, "Glenn Rhee")))) {
+			name
+		}
+	}`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"},{"name":"Andrea"}]}}`, js)
+}
+
+func TestFilterNotExists(t *testing.T) {
+	populateGraph(t)
+
+	query := `{
+		me(func: uid(1, 23, 24, 31)) @filter(not exists(friend)) {
+			name
+		}
+	}`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Glenn Rhee"}]}}`, js)
+}
+
+func TestIncludeSkip(t *testing.T) {
+	populateGraph(t)
+
+	query := `
+	query test($skipFriends: bool) {
+		f as var(func: uid(1)) @skip(if: $skipFriends) {
+			friend
+		}
+		me(func: uid(1)) {
+			name
+			friend @skip(if: $skipFriends) {
+				name
+			}
+		}
+		friends(func: uid(f)) @include(if: true) {
+			name
+		}
+	}`
+	js, err := processToFastJsonCtxVars(t, query, defaultContext(),
+		map[string]string{"$skipFriends": "true"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"}], "friends": []}}`, js)
+}
+
+func TestListVars(t *testing.T) {
+	populateGraph(t)
+
+	query := `
+	query test($ids: [uid], $names: [string]) {
+		me(func: uid($ids)) @filter(eq(name, $names)) {
+			name
+		}
+	}`
+	js, err := processToFastJsonCtxVars(t, query, defaultContext(), map[string]string{
+		"$ids":   `["0x1", "0x17", "0x18"]`,
+		"$names": `["Michonne", "Glenn Rhee"]`,
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Michonne"},{"name":"Glenn Rhee"}]}}`, js)
+}
+
 func TestFilterRootOverride(t *testing.T) {
 	populateGraph(t)
 
@@ -8080,3 +9032,28 @@ func TestExpandAll_empty_panic(t *testing.T) {
 	js := processToFastJsonNoErr(t, query)
 	require.JSONEq(t, `{"data":{"me":[]}}`, js)
 }
+
+func TestTerms(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			symbols(func: terms(symbol, first: 3, orderasc: term)) {
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"symbols": [{"@groupby": [{"symbol":"AAPL","count":1},{"symbol":"AMD","count":1},{"symbol":"AMZN","count":1}]}]}}`, js)
+}
+
+func TestTermsHashWithUidVar(t *testing.T) {
+	populateGraph(t)
+	query := `
+		{
+			f as var(func: uid(1, 23))
+			names(func: terms(full_name, uid(f))) {
+			}
+		}
+	`
+	js := processToFastJsonNoErr(t, query)
+	require.JSONEq(t, `{"data": {"names": [{"@groupby": [{"full_name":"Michonne's large name for hashing","count":1}]}]}}`, js)
+}
@@ -63,6 +63,7 @@ L:
 		case itemSubject:
 			rnq.Subject = strings.Trim(item.Val, " ")
 		case itemVarKeyword:
+			keyword := item.Val
 			it.Next()
 			if item = it.Item(); item.Typ != itemLeftRound {
 				return rnq, x.Errorf("Expected '(', found: %s", item.Val)
@@ -71,6 +72,18 @@ L:
 			if item = it.Item(); item.Typ != itemVarName {
 				return rnq, x.Errorf("Expected variable name, found: %s", item.Val)
 			}
+			// The variable is kept as uid(v) or val(v) and substituted with its value once the
+			// query of the upsert block has been executed. val(v) is kept in place of the object
+			// id as well, so that it can't be mistaken for the string literal "val(v)".
+			ref := keyword + "(" + strings.TrimSpace(item.Val) + ")"
+			switch {
+			case rnq.Subject == "" && keyword == "uid":
+				rnq.Subject = ref
+			case rnq.Subject == "":
+				return rnq, x.Errorf("Value variable can't be used as subject: %s", ref)
+			default:
+				rnq.ObjectId = ref
+			}
 
 			it.Next() // parse ')'
 
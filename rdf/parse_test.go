@@ -805,6 +805,34 @@ var testNQuads = []struct {
 		input:       `<alice> <age> "13"^^<xs:double> (salary=NaN) .`,
 		expectedErr: true,
 	},
+	{
+		input: `uid(u) <friend> uid(v) .`,
+		nq: api.NQuad{
+			Subject:   "uid(u)",
+			Predicate: "friend",
+			ObjectId:  "uid(v)",
+		},
+	},
+	{
+		input: `uid(u) <age> val(a) .`,
+		nq: api.NQuad{
+			Subject:   "uid(u)",
+			Predicate: "age",
+			ObjectId:  "val(a)",
+		},
+	},
+	{
+		input: `uid(u) <age> "val(a)" .`,
+		nq: api.NQuad{
+			Subject:     "uid(u)",
+			Predicate:   "age",
+			ObjectValue: &api.Value{&api.Value_DefaultVal{"val(a)"}},
+		},
+	},
+	{
+		input:       `val(a) <age> "13" .`,
+		expectedErr: true,
+	},
 }
 
 func TestLex(t *testing.T) {
@@ -32,7 +32,7 @@ const (
 	itemLeftRound                          // '(', 17
 	itemRightRound                         // ')', 18
 	itemStar                               // *, 19
-	itemVarKeyword                         // var, 20
+	itemVarKeyword                         // uid or val, 20
 	itemVarName                            // 21
 )
 
@@ -136,6 +136,15 @@ Loop:
 			l.Emit(itemText)
 			return lexVariable
 
+		case r == 'v':
+			// Value variables can only be used in place of the object.
+			if l.Depth != atObject {
+				return l.Errorf("Unexpected char 'v'")
+			}
+			l.Backup()
+			l.Emit(itemText)
+			return lexVariable
+
 		case isSpace(r):
 			continue
 		default:
@@ -418,10 +427,15 @@ func lexComment(l *lex.Lexer) lex.StateFn {
 	return nil // Stop the run loop.
 }
 
+// lexVariable lexes uid(v) or val(v), which refer to the variables of an upsert query.
 func lexVariable(l *lex.Lexer) lex.StateFn {
 	var r rune
 
-	for _, c := range "uid" {
+	keyword := "uid"
+	if l.Peek() == 'v' {
+		keyword = "val"
+	}
+	for _, c := range keyword {
 		if r = l.Next(); r != c {
 			return l.Errorf("Unexpected char '%c' when parsing var keyword", r)
 		}
@@ -8,6 +8,7 @@
 package schema
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/dgraph-io/dgraph/lex"
@@ -53,11 +54,12 @@ func parseDirective(it *lex.ItemIterator, schema *intern.SchemaUpdate, t types.T
 		}
 		schema.Directive = intern.SchemaUpdate_REVERSE
 	case "index":
-		if tokenizer, err := parseIndexDirective(it, schema.Predicate, t); err != nil {
+		if tokenizer, analyzer, err := parseIndexDirective(it, schema.Predicate, t); err != nil {
 			return err
 		} else {
 			schema.Directive = intern.SchemaUpdate_INDEX
 			schema.Tokenizer = tokenizer
+			schema.Analyzer = analyzer
 		}
 	case "count":
 		schema.Count = true
@@ -156,25 +158,27 @@ func parseScalarPair(it *lex.ItemIterator, predicate string) (*intern.SchemaUpda
 	return schema, nil
 }
 
-// parseIndexDirective works on "@index" or "@index(customtokenizer)".
+// parseIndexDirective works on "@index" or "@index(customtokenizer)". The fulltext tokenizer
+// can be followed by the configuration of its analyzer, e.g. "fulltext(stem: false)".
 func parseIndexDirective(it *lex.ItemIterator, predicate string,
-	typ types.TypeID) ([]string, error) {
+	typ types.TypeID) ([]string, *intern.AnalyzerConfig, error) {
 	var tokenizers []string
+	var analyzer *intern.AnalyzerConfig
 	var seen = make(map[string]bool)
 	var seenSortableTok bool
 
 	if typ == types.UidID || typ == types.DefaultID || typ == types.PasswordID {
-		return tokenizers, x.Errorf("Indexing not allowed on predicate %s of type %s",
+		return tokenizers, nil, x.Errorf("Indexing not allowed on predicate %s of type %s",
 			predicate, typ.Name())
 	}
 	if !it.Next() {
 		// Nothing to read.
-		return []string{}, x.Errorf("Invalid ending.")
+		return []string{}, nil, x.Errorf("Invalid ending.")
 	}
 	next := it.Item()
 	if next.Typ != itemLeftRound {
 		it.Prev() // Backup.
-		return []string{}, x.Errorf("Require type of tokenizer for pred: %s for indexing.",
+		return []string{}, nil, x.Errorf("Require type of tokenizer for pred: %s for indexing.",
 			predicate)
 	}
 
@@ -188,45 +192,169 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 		}
 		if next.Typ == itemComma {
 			if expectArg {
-				return nil, x.Errorf("Expected a tokenizer but got comma")
+				return nil, nil, x.Errorf("Expected a tokenizer but got comma")
 			}
 			expectArg = true
 			continue
 		}
 		if next.Typ != itemText {
-			return tokenizers, x.Errorf("Expected directive arg but got: %v", next.Val)
+			return tokenizers, nil, x.Errorf("Expected directive arg but got: %v", next.Val)
 		}
 		if !expectArg {
-			return tokenizers, x.Errorf("Expected a comma but got: %v", next)
+			return tokenizers, nil, x.Errorf("Expected a comma but got: %v", next)
 		}
 		// Look for custom tokenizer.
 		tokenizer, has := tok.GetTokenizer(strings.ToLower(next.Val))
 		if !has {
-			return tokenizers, x.Errorf("Invalid tokenizer %s", next.Val)
+			return tokenizers, nil, x.Errorf("Invalid tokenizer %s", next.Val)
 		}
 		tokenizerType, ok := types.TypeForName(tokenizer.Type())
 		x.AssertTrue(ok) // Type is validated during tokenizer loading.
 		if tokenizerType != typ {
-			return tokenizers,
+			return tokenizers, nil,
 				x.Errorf("Tokenizer: %s isn't valid for predicate: %s of type: %s",
 					tokenizer.Name(), predicate, typ.Name())
 		}
 		if _, found := seen[tokenizer.Name()]; found {
-			return tokenizers, x.Errorf("Duplicate tokenizers defined for pred %v",
+			return tokenizers, nil, x.Errorf("Duplicate tokenizers defined for pred %v",
 				predicate)
 		}
 		if tokenizer.IsSortable() {
 			if seenSortableTok {
-				return nil, x.Errorf("More than one sortable index encountered for: %v",
+				return nil, nil, x.Errorf("More than one sortable index encountered for: %v",
 					predicate)
 			}
 			seenSortableTok = true
 		}
+		if item, ok := it.PeekOne(); ok && item.Typ == itemLeftRound {
+			if tokenizer.Name() != tok.FtsTokenizerName("") {
+				return nil, nil, x.Errorf("Tokenizer %s can't be configured for pred %v",
+					tokenizer.Name(), predicate)
+			}
+			it.Next()
+			var err error
+			if analyzer, err = parseAnalyzerConfig(it, predicate); err != nil {
+				return nil, nil, err
+			}
+		}
 		tokenizers = append(tokenizers, tokenizer.Name())
 		seen[tokenizer.Name()] = true
 		expectArg = false
 	}
-	return tokenizers, nil
+	return tokenizers, analyzer, nil
+}
+
+// parseAnalyzerConfig parses the options of the full text analyzer, like
+// (lang: "en", stopwords: ["a", "the"], synonyms: "synonyms.txt", stem: false). The synonyms
+// can also be given inline, as a list of groups of comma separated words like
+// ["car, automobile", "fast, quick"].
+func parseAnalyzerConfig(it *lex.ItemIterator, predicate string) (*intern.AnalyzerConfig,
+	error) {
+	cfg := &intern.AnalyzerConfig{}
+	seen := make(map[string]bool)
+	for it.Next() {
+		next := it.Item()
+		switch next.Typ {
+		case itemRightRound:
+			if _, err := FullTextTokenizer(cfg); err != nil {
+				return nil, x.Wrapf(err, "Invalid fulltext options for pred %v", predicate)
+			}
+			return cfg, nil
+		case itemComma, itemNewLine:
+			continue
+		case itemText:
+		default:
+			return nil, x.Errorf("Expected fulltext option but got: %v", next.Val)
+		}
+
+		option := strings.ToLower(next.Val)
+		if seen[option] {
+			return nil, x.Errorf("Duplicate fulltext option %s for pred %v", option, predicate)
+		}
+		seen[option] = true
+		if !it.Next() || it.Item().Typ != itemColon {
+			return nil, x.Errorf("Expected colon after fulltext option %s", option)
+		}
+		if !it.Next() {
+			return nil, x.Errorf("Invalid ending.")
+		}
+		val := it.Item()
+		switch option {
+		case "lang":
+			lang, err := unquote(val)
+			if err != nil {
+				return nil, err
+			}
+			cfg.Lang = lang
+		case "synonyms":
+			var groups [][]string
+			if val.Typ == itemLeftSquare {
+				lines, err := parseStringList(it, option)
+				if err != nil {
+					return nil, err
+				}
+				groups = tok.ParseSynonyms(lines)
+			} else {
+				file, err := unquote(val)
+				if err != nil {
+					return nil, err
+				}
+				if groups, err = tok.LoadSynonyms(file); err != nil {
+					return nil, err
+				}
+				cfg.SynonymsFile = file
+			}
+			for _, g := range groups {
+				cfg.Synonyms = append(cfg.Synonyms, strings.Join(g, ","))
+			}
+		case "stem":
+			stem, err := strconv.ParseBool(val.Val)
+			if val.Typ != itemText || err != nil {
+				return nil, x.Errorf("Expected true or false for fulltext option stem, got: %v",
+					val.Val)
+			}
+			cfg.NoStem = !stem
+		case "stopwords":
+			if val.Typ != itemLeftSquare {
+				return nil, x.Errorf("Expected a list for fulltext option stopwords")
+			}
+			words, err := parseStringList(it, option)
+			if err != nil {
+				return nil, err
+			}
+			cfg.CustomStopwords = true
+			cfg.Stopwords = words
+		default:
+			return nil, x.Errorf("Invalid fulltext option %s for pred %v", option, predicate)
+		}
+	}
+	return nil, x.Errorf("Unclosed ( in fulltext options for pred %v", predicate)
+}
+
+// parseStringList parses a list of quoted strings, once its [ has been read.
+func parseStringList(it *lex.ItemIterator, option string) ([]string, error) {
+	list := []string{}
+	for it.Next() && it.Item().Typ != itemRightSquare {
+		if typ := it.Item().Typ; typ == itemComma || typ == itemNewLine {
+			continue
+		}
+		s, err := unquote(it.Item())
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+	}
+	if it.Item().Typ != itemRightSquare {
+		return nil, x.Errorf("Unclosed [ in fulltext option %s", option)
+	}
+	return list, nil
+}
+
+func unquote(item lex.Item) (string, error) {
+	if item.Typ != itemQuotedText {
+		return "", x.Errorf("Expected a quoted string but got: %v", item.Val)
+	}
+	return strconv.Unquote(item.Val)
 }
 
 // resolveTokenizers resolves default tokenizers and verifies tokenizers definitions.
@@ -250,6 +378,11 @@ func resolveTokenizers(updates []*intern.SchemaUpdate) error {
 		} else if len(schema.Tokenizer) > 0 && schema.Directive != intern.SchemaUpdate_INDEX {
 			return x.Errorf("Tokenizers present without indexing on attr %s", schema.Predicate)
 		}
+		if schema.Analyzer != nil {
+			if _, err := FullTextTokenizer(schema.Analyzer); err != nil {
+				return x.Wrapf(err, "Invalid fulltext options for attr %s", schema.Predicate)
+			}
+		}
 		// check for valid tokeniser types and duplicates
 		var seen = make(map[string]bool)
 		var seenSortableTok bool
@@ -281,9 +414,86 @@ func resolveTokenizers(updates []*intern.SchemaUpdate) error {
 	return nil
 }
 
+// parseTypeDeclaration parses a type definition like "type Person { name friend }", where
+// the fields may be separated by newlines or commas.
+func parseTypeDeclaration(it *lex.ItemIterator) (*intern.TypeUpdate, error) {
+	it.Next()
+	next := it.Item()
+	if next.Typ != itemText {
+		return nil, x.Errorf("Missing type name")
+	}
+	typ := &intern.TypeUpdate{TypeName: next.Val}
+	it.Next()
+	if next = it.Item(); next.Typ != itemLeftCurl {
+		return nil, x.Errorf("Missing { after type name: %s", typ.TypeName)
+	}
+
+	seen := make(map[string]bool)
+	for it.Next() {
+		next = it.Item()
+		switch next.Typ {
+		case itemRightCurl:
+			if len(typ.Fields) == 0 {
+				return nil, x.Errorf("Type %s must have at least one field", typ.TypeName)
+			}
+			it.Next()
+			next = it.Item()
+			if next.Typ == lex.ItemEOF {
+				it.Prev()
+				return typ, nil
+			}
+			if next.Typ != itemNewLine {
+				return nil, x.Errorf("Invalid ending after type: %s", typ.TypeName)
+			}
+			return typ, nil
+		case itemText:
+			if seen[next.Val] {
+				return nil, x.Errorf("Duplicate field %s in type %s", next.Val, typ.TypeName)
+			}
+			seen[next.Val] = true
+			typ.Fields = append(typ.Fields, next.Val)
+		case itemNewLine, itemComma:
+			// Fields can be separated by either.
+		case lex.ItemEOF:
+			return nil, x.Errorf("Unclosed { while parsing type: %s", typ.TypeName)
+		case lex.ItemError:
+			return nil, x.Errorf(next.Val)
+		default:
+			return nil, x.Errorf("Unexpected token: %v while parsing type %s", next,
+				typ.TypeName)
+		}
+	}
+	return nil, x.Errorf("Unclosed { while parsing type: %s", typ.TypeName)
+}
+
+// isTypeDeclaration tells apart "type Person {" from a predicate called type.
+func isTypeDeclaration(item lex.Item, it *lex.ItemIterator) bool {
+	if item.Val != "type" {
+		return false
+	}
+	next, ok := it.PeekOne()
+	return ok && next.Typ == itemText
+}
+
 // Parse parses a schema string and returns the schema representation for it.
+// Type definitions aren't accepted here, use ParseWithTypes for those.
 func Parse(s string) ([]*intern.SchemaUpdate, error) {
+	schemas, types, err := ParseWithTypes(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(types) > 0 {
+		return nil, x.Errorf("Unexpected type definition: %s", types[0].TypeName)
+	}
+	return schemas, nil
+}
+
+// ParseWithTypes parses a schema string which can contain both predicate
+// schema and type definitions.
+func ParseWithTypes(s string) ([]*intern.SchemaUpdate, []*intern.TypeUpdate, error) {
 	var schemas []*intern.SchemaUpdate
+	var types []*intern.TypeUpdate
+	seenTypes := make(map[string]bool)
 	l := lex.Lexer{Input: s}
 	l.Run(lexText)
 	it := l.NewIterator()
@@ -292,22 +502,32 @@ func Parse(s string) ([]*intern.SchemaUpdate, error) {
 		switch item.Typ {
 		case lex.ItemEOF:
 			if err := resolveTokenizers(schemas); err != nil {
-				return nil, x.Wrapf(err, "failed to enrich schema")
+				return nil, nil, x.Wrapf(err, "failed to enrich schema")
 			}
-			return schemas, nil
+			return schemas, types, nil
 		case itemText:
-			if schema, err := parseScalarPair(it, item.Val); err != nil {
-				return nil, err
+			if isTypeDeclaration(item, it) {
+				typ, err := parseTypeDeclaration(it)
+				if err != nil {
+					return nil, nil, err
+				}
+				if seenTypes[typ.TypeName] {
+					return nil, nil, x.Errorf("Duplicate definition for type: %s", typ.TypeName)
+				}
+				seenTypes[typ.TypeName] = true
+				types = append(types, typ)
+			} else if schema, err := parseScalarPair(it, item.Val); err != nil {
+				return nil, nil, err
 			} else {
 				schemas = append(schemas, schema)
 			}
 		case lex.ItemError:
-			return nil, x.Errorf(item.Val)
+			return nil, nil, x.Errorf(item.Val)
 		case itemNewLine:
 			// pass empty line
 		default:
-			return nil, x.Errorf("Unexpected token: %v while parsing schema", item)
+			return nil, nil, x.Errorf("Unexpected token: %v while parsing schema", item)
 		}
 	}
-	return nil, x.Errorf("Shouldn't reach here")
+	return nil, nil, x.Errorf("Shouldn't reach here")
 }
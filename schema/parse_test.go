@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 )
@@ -263,6 +264,85 @@ func TestParse8_Error(t *testing.T) {
 	require.Nil(t, schemas)
 }
 
+func TestParseVector(t *testing.T) {
+	reset()
+	schemas, err := Parse("embedding:float32vector @index(vector) .")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(schemas))
+	require.Equal(t, intern.Posting_VFLOAT, schemas[0].ValueType)
+	require.Equal(t, []string{"vector"}, schemas[0].Tokenizer)
+
+	_, err = Parse("name:string @index(vector) .")
+	require.Error(t, err)
+}
+
+func TestParseFullTextOptions(t *testing.T) {
+	f, err := ioutil.TempFile("", "synonyms")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("# vehicles\ncar, automobile,auto\n\nfast,quick\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	reset()
+	schemas, err := Parse(`description:string @index(term, fulltext(lang: "en",
+		stopwords: ["a", "the"], synonyms: "` + f.Name() + `", stem: false)) .`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(schemas))
+	require.Equal(t, []string{"term", "fulltext"}, schemas[0].Tokenizer)
+	require.Equal(t, &intern.AnalyzerConfig{
+		Lang:            "en",
+		Stopwords:       []string{"a", "the"},
+		CustomStopwords: true,
+		SynonymsFile:    f.Name(),
+		Synonyms:        []string{"car,automobile,auto", "fast,quick"},
+		NoStem:          true,
+	}, schemas[0].Analyzer)
+
+	State().Set("description", *schemas[0])
+	tokenizer, ok := State().FullTextAnalyzer("description")
+	require.True(t, ok)
+	require.Equal(t, tokenizer, State().Tokenizer("description")[1])
+	tokens, err := tok.BuildTokens("The quick automobiles", tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tokens))
+	expected, err := tok.BuildTokens("fast automobiles", tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, expected, tokens)
+
+	schemas, err = Parse(`description:string @index(fulltext(
+		synonyms: ["car, automobile,auto", "# vehicles", "fast,quick", "slow"])) .`)
+	require.NoError(t, err)
+	require.Equal(t, &intern.AnalyzerConfig{
+		Synonyms: []string{"car,automobile,auto", "fast,quick"},
+	}, schemas[0].Analyzer)
+
+	schemas, err = Parse(`description:string @index(fulltext(stopwords: [])) .`)
+	require.NoError(t, err)
+	require.Equal(t, &intern.AnalyzerConfig{Stopwords: []string{}, CustomStopwords: true},
+		schemas[0].Analyzer)
+}
+
+func TestParseFullTextOptions_Error(t *testing.T) {
+	reset()
+	for _, s := range []string{
+		`name:string @index(term(stem: false)) .`,
+		`name:string @index(fulltext(stem: maybe)) .`,
+		`name:string @index(fulltext(lang: "xx")) .`,
+		`name:string @index(fulltext(lang: en)) .`,
+		`name:string @index(fulltext(colour: "blue")) .`,
+		`name:string @index(fulltext(stem: false, stem: true)) .`,
+		`name:string @index(fulltext(synonyms: "/does/not/exist")) .`,
+		`name:string @index(fulltext(stopwords: "a")) .`,
+		`name:string @index(fulltext(synonyms: ["a,b", c])) .`,
+		`name:string @index(fulltext(synonyms: ["a,b")) .`,
+		`name:string @index(fulltext(stem: false) .`,
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
 func TestParseScalarList(t *testing.T) {
 	reset()
 	schemas, err := Parse(`
@@ -367,3 +447,46 @@ func TestParseUpsert(t *testing.T) {
 	`)
 	require.NoError(t, err)
 }
+
+func TestParseTypes(t *testing.T) {
+	reset()
+	schemas, types, err := ParseWithTypes(`
+		name: string @index(exact) .
+		type Person {
+			name
+			friend
+		}
+		type Animal { name, <http://schema.org/species> }
+		type: string .
+	`)
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+	require.Equal(t, "type", schemas[1].Predicate)
+	require.Equal(t, []*intern.TypeUpdate{
+		{TypeName: "Person", Fields: []string{"name", "friend"}},
+		{TypeName: "Animal", Fields: []string{"name", "http://schema.org/species"}},
+	}, types)
+}
+
+func TestParseTypes_Error(t *testing.T) {
+	reset()
+	_, _, err := ParseWithTypes("type Person {\n name\n")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Unclosed { while parsing type: Person")
+
+	_, _, err = ParseWithTypes("type Person {}")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Type Person must have at least one field")
+
+	_, _, err = ParseWithTypes("type Person { name, name }")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Duplicate field name in type Person")
+
+	_, _, err = ParseWithTypes("type Person { name }\ntype Person { age }")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Duplicate definition for type: Person")
+
+	_, err = Parse("type Person { name }")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Unexpected type definition: Person")
+}
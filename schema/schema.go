@@ -10,6 +10,7 @@ package schema
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/dgraph-io/badger"
@@ -28,6 +29,8 @@ var (
 
 func (s *state) init() {
 	s.predicate = make(map[string]*intern.SchemaUpdate)
+	s.types = make(map[string]*intern.TypeUpdate)
+	s.analyzers = make(map[string]tok.Tokenizer)
 	s.elog = trace.NewEventLog("Dgraph", "Schema")
 }
 
@@ -35,6 +38,10 @@ type state struct {
 	sync.RWMutex
 	// Map containing predicate to type information.
 	predicate map[string]*intern.SchemaUpdate
+	// Map containing type name to the list of its fields.
+	types map[string]*intern.TypeUpdate
+	// Map containing predicate to the full text tokenizer built from its analyzer config.
+	analyzers map[string]tok.Tokenizer
 	elog      trace.EventLog
 }
 
@@ -48,11 +55,15 @@ func (s *state) DeleteAll() {
 	defer s.Unlock()
 
 	for pred := range s.predicate {
-		// We set schema for _predicate_, hence it shouldn't be deleted.
-		if pred != x.PredicateListAttr {
+		// We set schema for _predicate_ and _type_, hence they shouldn't be deleted.
+		if pred != x.PredicateListAttr && pred != x.TypeAttr {
 			delete(s.predicate, pred)
+			delete(s.analyzers, pred)
 		}
 	}
+	for name := range s.types {
+		delete(s.types, name)
+	}
 }
 
 // Delete updates the schema in memory and disk
@@ -62,6 +73,7 @@ func (s *state) Delete(attr string) error {
 
 	x.Printf("Deleting schema for predicate: [%s]", attr)
 	delete(s.predicate, attr)
+	delete(s.analyzers, attr)
 	txn := pstore.NewTransactionAt(1, true)
 	if err := txn.Delete(x.SchemaKey(attr)); err != nil {
 		return err
@@ -86,6 +98,16 @@ func (s *state) Set(pred string, schema intern.SchemaUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.predicate[pred] = &schema
+	delete(s.analyzers, pred)
+	if schema.Analyzer != nil {
+		// The config was validated when parsing the schema.
+		t, err := FullTextTokenizer(schema.Analyzer)
+		if err != nil {
+			x.Printf("Error while building fulltext analyzer for %s: %v", pred, err)
+		} else {
+			s.analyzers[pred] = t
+		}
+	}
 	s.elog.Printf(logUpdate(schema, pred))
 }
 
@@ -100,6 +122,44 @@ func (s *state) Get(pred string) (intern.SchemaUpdate, bool) {
 	return *schema, true
 }
 
+// SetType sets the definition for the given type in memory. Like Set, type
+// updates must flow through the worker which syncs them to db.
+func (s *state) SetType(typ intern.TypeUpdate) {
+	s.Lock()
+	defer s.Unlock()
+	s.types[typ.TypeName] = &typ
+	s.elog.Printf("Setting type %s with fields: %v", typ.TypeName, typ.Fields)
+}
+
+// DeleteType deletes the definition of the given type from memory.
+func (s *state) DeleteType(name string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.types, name)
+}
+
+// GetType gets the definition for the given type.
+func (s *state) GetType(name string) (intern.TypeUpdate, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	typ, has := s.types[name]
+	if !has {
+		return intern.TypeUpdate{}, false
+	}
+	return *typ, true
+}
+
+// Types returns the names of all the defined types.
+func (s *state) Types() []string {
+	s.RLock()
+	defer s.RUnlock()
+	out := make([]string, 0, len(s.types))
+	for k := range s.types {
+		out = append(out, k)
+	}
+	return out
+}
+
 // TypeOf returns the schema type of predicate
 func (s *state) TypeOf(pred string) (types.TypeID, error) {
 	s.RLock()
@@ -152,6 +212,10 @@ func (s *state) Tokenizer(pred string) []tok.Tokenizer {
 	x.AssertTruef(ok, "schema state not found for %s", pred)
 	var tokenizers []tok.Tokenizer
 	for _, it := range schema.Tokenizer {
+		if t, ok := s.analyzers[pred]; ok && it == tok.FtsTokenizerName("") {
+			tokenizers = append(tokenizers, t)
+			continue
+		}
 		t, has := tok.GetTokenizer(it)
 		x.AssertTruef(has, "Invalid tokenizer %s", it)
 		tokenizers = append(tokenizers, t)
@@ -159,6 +223,28 @@ func (s *state) Tokenizer(pred string) []tok.Tokenizer {
 	return tokenizers
 }
 
+// FullTextAnalyzer returns the full text tokenizer configured for the given predicate, if any.
+func (s *state) FullTextAnalyzer(pred string) (tok.Tokenizer, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	t, ok := s.analyzers[pred]
+	return t, ok
+}
+
+// FullTextTokenizer builds the full text tokenizer for an analyzer config.
+func FullTextTokenizer(cfg *intern.AnalyzerConfig) (tok.Tokenizer, error) {
+	tc := tok.AnalyzerConfig{
+		Lang:            cfg.Lang,
+		StopWords:       cfg.Stopwords,
+		CustomStopWords: cfg.CustomStopwords,
+		NoStem:          cfg.NoStem,
+	}
+	for _, group := range cfg.Synonyms {
+		tc.Synonyms = append(tc.Synonyms, strings.Split(group, ","))
+	}
+	return tok.NewFullTextTokenizer(tc)
+}
+
 // TokenizerNames returns the tokenizer names for given predicate
 func (s *state) TokenizerNames(pred string) []string {
 	s.RLock()
@@ -283,6 +369,26 @@ func LoadFromDb() error {
 		x.Checkf(s.Unmarshal(val), "Error while loading schema from db")
 		State().Set(attr, s)
 	}
+	return loadTypesFromDb(txn)
+}
+
+func loadTypesFromDb(txn *badger.Txn) error {
+	prefix := x.TypePrefix()
+	itr := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer itr.Close()
+
+	for itr.Seek(prefix); itr.ValidForPrefix(prefix); itr.Next() {
+		val, err := itr.Item().Value()
+		if err != nil {
+			return err
+		}
+		if len(val) == 0 {
+			continue
+		}
+		var t intern.TypeUpdate
+		x.Checkf(t.Unmarshal(val), "Error while loading types from db")
+		State().SetType(t)
+	}
 	return nil
 }
 
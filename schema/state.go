@@ -26,6 +26,7 @@ const (
 	itemUnderscore
 	itemLeftSquare
 	itemRightSquare
+	itemQuotedText // quoted string
 )
 
 func lexText(l *lex.Lexer) lex.StateFn {
@@ -65,6 +66,11 @@ Loop:
 			l.Emit(itemLeftSquare)
 		case r == ']':
 			l.Emit(itemRightSquare)
+		case r == '"':
+			if err := l.LexQuotedString(); err != nil {
+				return l.Errorf("Invalid schema: %v", err)
+			}
+			l.Emit(itemQuotedText)
 		case r == '_':
 			// Predicates can start with _.
 			return lexWord
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package tok
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/analysis/token/stop"
+	"github.com/blevesearch/bleve/analysis/token/unicodenorm"
+	"github.com/blevesearch/bleve/analysis/tokenizer/unicode"
+	"github.com/blevesearch/blevex/stemmer"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// AnalyzerConfig configures the analyzer of a full text index. Whatever isn't configured is
+// taken from the analyzer of the language.
+type AnalyzerConfig struct {
+	// Lang is the language of the stemmer and of the default stop words. English if empty.
+	Lang string
+	// StopWords replace the stop words of the language if CustomStopWords is set, even if
+	// there are none.
+	StopWords       []string
+	CustomStopWords bool
+	// Synonyms are groups of words which are indexed and searched as the first word of their
+	// group.
+	Synonyms [][]string
+	NoStem   bool
+}
+
+// synonymFilter replaces the words having a synonym by the first word of their group.
+type synonymFilter map[string][]byte
+
+func (f synonymFilter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	for _, token := range input {
+		if s, ok := f[string(token.Term)]; ok {
+			token.Term = s
+		}
+	}
+	return input
+}
+
+func langName(code string) (string, bool) {
+	for name, c := range langToCode {
+		if c == code {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// NewFullTextTokenizer returns a full text tokenizer using the analyzer described by cfg. It
+// has the same name and identifier as the full text tokenizer of the language, so that the
+// full text functions work with it.
+func NewFullTextTokenizer(cfg AnalyzerConfig) (Tokenizer, error) {
+	if cfg.Lang == "" {
+		cfg.Lang = "en"
+	}
+	name, ok := langName(cfg.Lang)
+	if !ok || stopwords[name] == nil {
+		return nil, x.Errorf("Full text analyzer can't be configured for language: %s", cfg.Lang)
+	}
+
+	normalizer, err := unicodenorm.NewUnicodeNormalizeFilter(normalizerFormNFKC)
+	if err != nil {
+		return nil, err
+	}
+	filters := []analysis.TokenFilter{lowercase.NewLowerCaseFilter(), normalizer}
+
+	if len(cfg.Synonyms) > 0 {
+		synonyms := make(synonymFilter)
+		for _, group := range cfg.Synonyms {
+			if len(group) == 0 {
+				continue
+			}
+			first := []byte(strings.ToLower(group[0]))
+			for _, w := range group[1:] {
+				synonyms[strings.ToLower(w)] = first
+			}
+		}
+		filters = append(filters, synonyms)
+	}
+
+	stopTokens := analysis.NewTokenMap()
+	if cfg.CustomStopWords {
+		for _, w := range cfg.StopWords {
+			stopTokens.AddToken(strings.ToLower(w))
+		}
+	} else {
+		for _, w := range stopwords[name] {
+			stopTokens.AddToken(w.(string))
+		}
+	}
+	filters = append(filters, stop.NewStopTokensFilter(stopTokens))
+
+	if !cfg.NoStem {
+		stem, err := stemmer.NewStemmerFilter(name)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, stem)
+	}
+
+	return FullTextTokenizer{
+		Lang: cfg.Lang,
+		analyzer: &analysis.Analyzer{
+			Tokenizer:    unicode.NewUnicodeTokenizer(),
+			TokenFilters: filters,
+		},
+	}, nil
+}
+
+// LoadSynonyms reads groups of synonyms from a file, one group per line with the words
+// separated by commas. Empty lines and lines starting with # are skipped.
+func LoadSynonyms(filename string) ([][]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, x.Wrapf(err, "while opening synonyms file")
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, x.Wrapf(err, "while reading synonyms file")
+	}
+	return ParseSynonyms(lines), nil
+}
+
+// ParseSynonyms returns the groups of synonyms in the lines, one group per line with the words
+// separated by commas. Empty lines, lines starting with # and groups of a single word are
+// skipped.
+func ParseSynonyms(lines []string) [][]string {
+	var groups [][]string
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		var group []string
+		for _, w := range strings.Split(line, ",") {
+			if w = strings.TrimSpace(w); len(w) > 0 {
+				group = append(group, w)
+			}
+		}
+		if len(group) > 1 {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
@@ -8,8 +8,12 @@
 package tok
 
 import (
+	"encoding/binary"
+	"sort"
+
 	"github.com/dgraph-io/dgraph/x"
 
+	"github.com/blevesearch/bleve/analysis"
 	"github.com/blevesearch/bleve/analysis/analyzer/custom"
 	"github.com/blevesearch/bleve/analysis/lang/cjk"
 	"github.com/blevesearch/bleve/analysis/token/lowercase"
@@ -157,6 +161,137 @@ func FtsTokenizerName(lang string) string {
 	return FTSTokenizerName + lang
 }
 
+// TextToken is a token of the full text index along with the position of the word it comes
+// from, counting words from one.
+type TextToken struct {
+	Token string
+	Pos   uint32
+}
+
+// asFullText returns t if it's a full text tokenizer. The tokenizers of the languages are
+// registered as pointers, and the default one as a value.
+func asFullText(t Tokenizer) (FullTextTokenizer, bool) {
+	switch ft := t.(type) {
+	case FullTextTokenizer:
+		return ft, true
+	case *FullTextTokenizer:
+		return *ft, true
+	}
+	return FullTextTokenizer{}, false
+}
+
+// TokenPositions returns the encoded tokens of str for the full text tokenizer t in the order
+// they occur, without removing duplicates. Stop words don't get a token but still take up a
+// position, so that the distance between two words is the same as in str.
+func TokenPositions(t Tokenizer, str string) ([]TextToken, error) {
+	ft, ok := asFullText(t)
+	if !ok {
+		return nil, x.Errorf("Token positions are only recorded for full text indices, got: %s",
+			t.Name())
+	}
+	analyzer, err := ft.getAnalyzer()
+	if err != nil {
+		return nil, err
+	}
+	tokenStream := analyzer.Analyze([]byte(str))
+	tokens := make([]TextToken, 0, len(tokenStream))
+	for _, token := range tokenStream {
+		tokens = append(tokens, TextToken{
+			Token: encodeToken(string(token.Term), t.Identifier()),
+			Pos:   uint32(token.Position),
+		})
+	}
+	return tokens, nil
+}
+
+// TokenSpan is a token along with the byte offsets of the word it comes from.
+type TokenSpan struct {
+	Token      string
+	Start, End int
+}
+
+// TokenSpans returns the encoded tokens of str for the term or full text tokenizer t in the
+// order they occur, along with where their words are in str.
+func TokenSpans(t Tokenizer, str string) ([]TokenSpan, error) {
+	var analyzer *analysis.Analyzer
+	var err error
+	if tt, ok := t.(TermTokenizer); ok {
+		analyzer, err = bleveCache.AnalyzerNamed(tt.Name())
+	} else if ft, ok := asFullText(t); ok {
+		analyzer, err = ft.getAnalyzer()
+	} else {
+		return nil, x.Errorf("Token spans are only available for term and full text indices,"+
+			" got: %s", t.Name())
+	}
+	if err != nil {
+		return nil, err
+	}
+	tokenStream := analyzer.Analyze([]byte(str))
+	spans := make([]TokenSpan, 0, len(tokenStream))
+	for _, token := range tokenStream {
+		spans = append(spans, TokenSpan{
+			Token: encodeToken(string(token.Term), t.Identifier()),
+			Start: token.Start,
+			End:   token.End,
+		})
+	}
+	return spans, nil
+}
+
+func (t FullTextTokenizer) getAnalyzer() (*analysis.Analyzer, error) {
+	if t.analyzer != nil {
+		return t.analyzer, nil
+	}
+	return bleveCache.AnalyzerNamed(t.Name())
+}
+
+// FullTextLengthsToken returns the token of the full text index under which the number of words
+// of every indexed value is kept, along with their total, for relevance scoring. Only predicates
+// with a single value per node keep them. Full text tokens are never empty, so it can't clash
+// with any of them.
+func FullTextLengthsToken() string {
+	return encodeToken("", FullTextTokenizer{}.Identifier())
+}
+
+// PositionsByToken groups the positions of the tokens by token, in increasing order.
+func PositionsByToken(tokens []TextToken) map[string][]uint32 {
+	positions := make(map[string][]uint32)
+	for _, t := range tokens {
+		positions[t.Token] = append(positions[t.Token], t.Pos)
+	}
+	for _, p := range positions {
+		sort.Slice(p, func(i, j int) bool { return p[i] < p[j] })
+	}
+	return positions
+}
+
+// EncodePositions encodes increasing positions as the uvarint differences between them.
+func EncodePositions(positions []uint32) []byte {
+	buf := make([]byte, 0, len(positions))
+	var last uint32
+	for _, p := range positions {
+		buf = x.AppendUvarint(buf, uint64(p-last))
+		last = p
+	}
+	return buf
+}
+
+// DecodePositions decodes positions encoded by EncodePositions.
+func DecodePositions(buf []byte) ([]uint32, error) {
+	var positions []uint32
+	var last uint32
+	for len(buf) > 0 {
+		d, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return nil, x.Errorf("Invalid encoding of token positions")
+		}
+		last += uint32(d)
+		positions = append(positions, last)
+		buf = buf[n:]
+	}
+	return positions, nil
+}
+
 func stemmerName(lang string) string {
 	return stemmer.Name + lang
 }
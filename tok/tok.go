@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/blevesearch/bleve/analysis"
 	farm "github.com/dgryski/go-farm"
 	geom "github.com/twpayne/go-geom"
 
@@ -76,6 +77,7 @@ func init() {
 	registerTokenizer(BoolTokenizer{})
 	registerTokenizer(TrigramTokenizer{})
 	registerTokenizer(HashTokenizer{})
+	registerTokenizer(VectorTokenizer{})
 	initFullTextTokenizers()
 }
 
@@ -246,11 +248,17 @@ func (t ExactTokenizer) IsLossy() bool    { return false }
 // Full text tokenizer, with language support
 type FullTextTokenizer struct {
 	Lang string
+	// analyzer is only set for tokenizers built from an analyzer configuration, see
+	// NewFullTextTokenizer. The others use the analyzer defined for their language.
+	analyzer *analysis.Analyzer
 }
 
 func (t FullTextTokenizer) Name() string { return FtsTokenizerName(t.Lang) }
 func (t FullTextTokenizer) Type() string { return "string" }
 func (t FullTextTokenizer) Tokens(v interface{}) ([]string, error) {
+	if t.analyzer != nil {
+		return analyzerTokens(t.analyzer, v.(string)), nil
+	}
 	return getBleveTokens(t.Name(), v.(string))
 }
 func (t FullTextTokenizer) Identifier() byte { return 0x8 }
@@ -267,13 +275,16 @@ func getBleveTokens(name string, str string) ([]string, error) {
 		terms[0] = str
 		return terms, nil
 	}
+	return analyzerTokens(analyzer, str), nil
+}
+
+func analyzerTokens(analyzer *analysis.Analyzer, str string) []string {
 	tokenStream := analyzer.Analyze([]byte(str))
 	terms := make([]string, len(tokenStream))
 	for i, token := range tokenStream {
 		terms[i] = string(token.Term)
 	}
-	terms = x.RemoveDuplicates(terms)
-	return terms, nil
+	return x.RemoveDuplicates(terms)
 }
 
 func encodeInt(val int64) string {
@@ -359,6 +370,25 @@ func (t HashTokenizer) Identifier() byte { return 0xB }
 func (t HashTokenizer) IsSortable() bool { return false }
 func (t HashTokenizer) IsLossy() bool    { return true }
 
+// VectorTokenizer indexes float32 vectors for approximate nearest neighbour search.
+type VectorTokenizer struct{}
+
+func (t VectorTokenizer) Name() string { return "vector" }
+func (t VectorTokenizer) Type() string { return "float32vector" }
+func (t VectorTokenizer) Tokens(v interface{}) ([]string, error) {
+	vec, ok := v.([]float32)
+	if !ok {
+		return nil, x.Errorf("Vector tokenizer only supported for float32vector types")
+	}
+	if len(vec) == 0 {
+		return nil, nil
+	}
+	return vectorBuckets(vec, 0), nil
+}
+func (t VectorTokenizer) Identifier() byte { return 0xC }
+func (t VectorTokenizer) IsSortable() bool { return false }
+func (t VectorTokenizer) IsLossy() bool    { return true }
+
 // PluginTokenizer is implemented by external plugins loaded dynamically via
 // *.so files. It follows the implementation semantics of the Tokenizer
 // interface.
@@ -133,6 +133,104 @@ func TestFullTextTokenizerLang(t *testing.T) {
 	require.Equal(t, []string{encodeToken("auffass", id), encodeToken("katz", id)}, tokens)
 }
 
+func TestNewFullTextTokenizer(t *testing.T) {
+	tokenizer, err := NewFullTextTokenizer(AnalyzerConfig{
+		StopWords:       []string{"Bank"},
+		CustomStopWords: true,
+		Synonyms:        [][]string{{"car", "automobile", "Auto"}},
+		NoStem:          true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "fulltexten", tokenizer.Name())
+
+	tokens, err := BuildTokens("The bank of the auto Rentals", tokenizer)
+	require.NoError(t, err)
+	id := tokenizer.Identifier()
+	require.Equal(t, []string{encodeToken("car", id), encodeToken("of", id),
+		encodeToken("rentals", id), encodeToken("the", id)}, tokens)
+
+	tokenizer, err = NewFullTextTokenizer(AnalyzerConfig{Lang: "de"})
+	require.NoError(t, err)
+	tokens, err = BuildTokens("Katzen und Auffassung", tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, []string{encodeToken("auffass", id), encodeToken("katz", id)}, tokens)
+
+	_, err = NewFullTextTokenizer(AnalyzerConfig{Lang: "xx"})
+	require.Error(t, err)
+}
+
+func TestTokenPositions(t *testing.T) {
+	tokenizer, has := GetTokenizer("fulltext")
+	require.True(t, has)
+
+	tokens, err := TokenPositions(tokenizer, "The Bank of America, not the bank of Amsterdam")
+	require.NoError(t, err)
+	id := tokenizer.Identifier()
+	require.Equal(t, []TextToken{
+		{encodeToken("bank", id), 2},
+		{encodeToken("america", id), 4},
+		{encodeToken("bank", id), 7},
+		{encodeToken("amsterdam", id), 9},
+	}, tokens)
+	require.Equal(t, map[string][]uint32{
+		encodeToken("bank", id):      {2, 7},
+		encodeToken("america", id):   {4},
+		encodeToken("amsterdam", id): {9},
+	}, PositionsByToken(tokens))
+
+	// The tokenizers of the languages are registered as pointers.
+	tokenizer, has = GetTokenizer(FtsTokenizerName("fr"))
+	require.True(t, has)
+	tokens, err = TokenPositions(tokenizer, "la banque de France")
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, uint32(4), tokens[1].Pos)
+
+	_, err = TokenPositions(TermTokenizer{}, "bank of america")
+	require.Error(t, err)
+}
+
+func TestTokenSpans(t *testing.T) {
+	tokenizer, has := GetTokenizer("fulltext")
+	require.True(t, has)
+	spans, err := TokenSpans(tokenizer, "The Banks of America")
+	require.NoError(t, err)
+	id := tokenizer.Identifier()
+	require.Equal(t, []TokenSpan{
+		{Token: encodeToken("bank", id), Start: 4, End: 9},
+		{Token: encodeToken("america", id), Start: 13, End: 20},
+	}, spans)
+
+	spans, err = TokenSpans(TermTokenizer{}, "Bank of America")
+	require.NoError(t, err)
+	id = TermTokenizer{}.Identifier()
+	require.Equal(t, []TokenSpan{
+		{Token: encodeToken("bank", id), Start: 0, End: 4},
+		{Token: encodeToken("of", id), Start: 5, End: 7},
+		{Token: encodeToken("america", id), Start: 8, End: 15},
+	}, spans)
+
+	tokenizer, has = GetTokenizer(FtsTokenizerName("fr"))
+	require.True(t, has)
+	spans, err = TokenSpans(tokenizer, "la banque de France")
+	require.NoError(t, err)
+	require.Len(t, spans, 2)
+	require.Equal(t, 13, spans[1].Start)
+
+	_, err = TokenSpans(ExactTokenizer{}, "bank of america")
+	require.Error(t, err)
+}
+
+func TestEncodePositions(t *testing.T) {
+	positions := []uint32{1, 2, 130, 70000}
+	decoded, err := DecodePositions(EncodePositions(positions))
+	require.NoError(t, err)
+	require.Equal(t, positions, decoded)
+
+	_, err = DecodePositions([]byte{0x80})
+	require.Error(t, err)
+}
+
 func TestTermTokenizer(t *testing.T) {
 	tokenizer, has := GetTokenizer("term")
 	require.True(t, has)
@@ -170,6 +268,32 @@ func TestTrigramTokenizer(t *testing.T) {
 	require.Equal(t, expected, tokens)
 }
 
+func TestVectorTokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer("vector")
+	require.True(t, has)
+	require.NotNil(t, tokenizer)
+	v := []float32{0.1, 0.9, -0.3, 0.4}
+	tokens, err := BuildTokens(v, tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, vectorTables, len(tokens))
+
+	// The same vector always hashes into the same buckets, and a scaled copy of it lies on the
+	// same side of all the hyperplanes.
+	again, err := BuildTokens([]float32{0.2, 1.8, -0.6, 0.8}, tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, tokens, again)
+
+	probes, err := VectorProbeTokens(v, 0)
+	require.NoError(t, err)
+	require.Equal(t, tokens, probes)
+	probes, err = VectorProbeTokens(v, 2)
+	require.NoError(t, err)
+	require.Equal(t, 3*vectorTables, len(probes))
+
+	_, err = BuildTokens("not a vector", tokenizer)
+	require.Error(t, err)
+}
+
 func TestGetBleveTokens(t *testing.T) {
 	val := "Our chief weapon is surprise...surprise and fear...fear and surprise...." +
 		"Our two weapons are fear and surprise...and ruthless efficiency.... " +
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package tok
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The vector index is an approximate nearest neighbour index based on random hyperplane
+// locality sensitive hashing. Each vector is hashed into one bucket per table, the bits of the
+// bucket saying on which side of each of the table's hyperplanes the vector lies. Vectors with a
+// small angle between them are likely to end up in the same bucket of some table, so the
+// buckets are stored as ordinary index tokens and the postings of a query's buckets give the
+// candidates for a nearest neighbour search.
+const (
+	vectorTables = 8
+	vectorBits   = 12
+)
+
+// The hyperplanes only depend on the dimension of the vectors, so that all the servers and the
+// bulk loader hash a vector into the same buckets.
+var planes = struct {
+	sync.Mutex
+	m map[int][][]float32
+}{m: make(map[int][][]float32)}
+
+func hyperplanes(dim int) [][]float32 {
+	planes.Lock()
+	defer planes.Unlock()
+	if p, ok := planes.m[dim]; ok {
+		return p
+	}
+	r := rand.New(rand.NewSource(int64(dim)))
+	p := make([][]float32, vectorTables*vectorBits)
+	for i := range p {
+		p[i] = make([]float32, dim)
+		for j := range p[i] {
+			p[i][j] = float32(r.NormFloat64())
+		}
+	}
+	planes.m[dim] = p
+	return p
+}
+
+func vectorToken(table int, bucket uint16) string {
+	return string([]byte{byte(table), byte(bucket >> 8), byte(bucket)})
+}
+
+type hyperplaneSide struct {
+	bit    uint
+	margin float64
+}
+
+// VectorProbeTokens returns the index tokens of the buckets to look at for the neighbours of v.
+// Besides the bucket of v, it returns for every table the flips buckets that differ from it in
+// the bits for which v lies closest to the hyperplane.
+func VectorProbeTokens(v []float32, flips int) ([]string, error) {
+	if len(v) == 0 {
+		return nil, x.Errorf("Can't search for neighbours of an empty vector")
+	}
+	tokens := vectorBuckets(v, flips)
+	id := VectorTokenizer{}.Identifier()
+	for i := range tokens {
+		tokens[i] = encodeToken(tokens[i], id)
+	}
+	return tokens, nil
+}
+
+func vectorBuckets(v []float32, flips int) []string {
+	if flips > vectorBits {
+		flips = vectorBits
+	}
+	p := hyperplanes(len(v))
+	var tokens []string
+	sides := make([]hyperplaneSide, vectorBits)
+	for t := 0; t < vectorTables; t++ {
+		var bucket uint16
+		for b := 0; b < vectorBits; b++ {
+			var dot float64
+			for i, f := range p[t*vectorBits+b] {
+				dot += float64(f) * float64(v[i])
+			}
+			if dot >= 0 {
+				bucket |= 1 << uint(b)
+			}
+			sides[b] = hyperplaneSide{bit: uint(b), margin: math.Abs(dot)}
+		}
+		tokens = append(tokens, vectorToken(t, bucket))
+		sort.Slice(sides, func(i, j int) bool { return sides[i].margin < sides[j].margin })
+		for _, s := range sides[:flips] {
+			tokens = append(tokens, vectorToken(t, bucket^(1<<s.bit)))
+		}
+	}
+	return tokens
+}
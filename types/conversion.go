@@ -77,6 +77,12 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				*res = w
 			case PasswordID:
 				*res = string(data)
+			case VFloatID:
+				v, err := BytesToVFloat(data)
+				if err != nil {
+					return to, err
+				}
+				*res = v
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -132,6 +138,12 @@ func Convert(from Val, toID TypeID) (Val, error) {
 					return to, err
 				}
 				*res = password
+			case VFloatID:
+				v, err := ParseVFloat(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = v
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -314,6 +326,23 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				return to, cantConvert(fromID, toID)
 			}
 		}
+	case VFloatID:
+		{
+			vc, err := BytesToVFloat(data)
+			if err != nil {
+				return to, err
+			}
+			switch toID {
+			case VFloatID:
+				*res = vc
+			case BinaryID:
+				*res = data
+			case StringID, DefaultID:
+				*res = VFloatToString(vc)
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
 	default:
 		return to, cantConvert(fromID, toID)
 	}
@@ -444,6 +473,20 @@ func Marshal(from Val, to *Val) error {
 		default:
 			return cantConvert(fromID, toID)
 		}
+	case VFloatID:
+		vc, ok := val.([]float32)
+		if !ok {
+			return x.Errorf("Expected a float32vector type")
+		}
+		switch toID {
+		case StringID, DefaultID:
+			*res = VFloatToString(vc)
+		case BinaryID:
+			// Marshal Binary
+			*res = VFloatToBytes(vc)
+		default:
+			return cantConvert(fromID, toID)
+		}
 
 	default:
 		return cantConvert(fromID, toID)
@@ -513,6 +556,14 @@ func ObjectValue(id TypeID, value interface{}) (*api.Value, error) {
 			return def, x.Errorf("Expected value of type password. Got : %v", value)
 		}
 		return &api.Value{&api.Value_PasswordVal{v}}, nil
+	// There is no vector value in the api, so vectors are sent in their binary form
+	// and converted back using the schema type.
+	case VFloatID:
+		b, err := toBinary(id, value)
+		if err != nil {
+			return def, err
+		}
+		return &api.Value{&api.Value_BytesVal{b}}, nil
 	default:
 		return def, x.Errorf("ObjectValue not available for: %v", id)
 	}
@@ -546,6 +597,8 @@ func (v Val) MarshalJSON() ([]byte, error) {
 		return json.Marshal(v.Value.(string))
 	case PasswordID:
 		return json.Marshal(v.Value.(string))
+	case VFloatID:
+		return json.Marshal(v.Value.([]float32))
 	}
 	return nil, x.Errorf("Invalid type for MarshalJSON: %v", v.Tid)
 }
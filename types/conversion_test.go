@@ -105,6 +105,36 @@ func TestConversionToDateTime(t *testing.T) {
 	}
 }
 
+func TestConvertVFloat(t *testing.T) {
+	in := Val{StringID, []byte("[0.5, -1, 2.25]")}
+	v, err := Convert(in, VFloatID)
+	if err != nil {
+		t.Fatalf("Unexpected error converting string to float32vector: %v", err)
+	}
+	want := []float32{0.5, -1, 2.25}
+	if !reflect.DeepEqual(v.Value, want) {
+		t.Errorf("Converting string to float32vector: Expected %v, got %v", want, v.Value)
+	}
+
+	b := ValueForType(BinaryID)
+	if err := Marshal(v, &b); err != nil {
+		t.Fatalf("Unexpected error marshalling float32vector: %v", err)
+	}
+	back, err := Convert(Val{VFloatID, b.Value.([]byte)}, StringID)
+	if err != nil {
+		t.Fatalf("Unexpected error converting float32vector to string: %v", err)
+	}
+	if back.Value.(string) != "[0.5, -1, 2.25]" {
+		t.Errorf("Converting float32vector to string: got %v", back.Value)
+	}
+
+	for _, s := range []string{"", "[]", "0.5, 1", "[1, a]", "[1, NaN]"} {
+		if _, err := Convert(Val{StringID, []byte(s)}, VFloatID); err == nil {
+			t.Errorf("Expected error converting %q to float32vector", s)
+		}
+	}
+}
+
 /*
 func TestSameConversionFloat(t *testing.T) {
 	data := []struct {
@@ -33,18 +33,20 @@ const (
 	UidID      = TypeID(intern.Posting_UID)
 	PasswordID = TypeID(intern.Posting_PASSWORD)
 	DefaultID  = TypeID(intern.Posting_DEFAULT)
+	VFloatID   = TypeID(intern.Posting_VFLOAT)
 )
 
 var typeNameMap = map[string]TypeID{
-	"int":      IntID,
-	"float":    FloatID,
-	"string":   StringID,
-	"bool":     BoolID,
-	"datetime": DateTimeID,
-	"geo":      GeoID,
-	"uid":      UidID,
-	"password": PasswordID,
-	"default":  DefaultID,
+	"int":           IntID,
+	"float":         FloatID,
+	"string":        StringID,
+	"bool":          BoolID,
+	"datetime":      DateTimeID,
+	"geo":           GeoID,
+	"uid":           UidID,
+	"password":      PasswordID,
+	"default":       DefaultID,
+	"float32vector": VFloatID,
 }
 
 type TypeID intern.Posting_ValType
@@ -75,6 +77,8 @@ func (t TypeID) Name() string {
 		return "default"
 	case BinaryID:
 		return "binary"
+	case VFloatID:
+		return "float32vector"
 	}
 	return ""
 }
@@ -139,6 +143,10 @@ func ValueForType(id TypeID) Val {
 		var p string
 		return Val{PasswordID, p}
 
+	case VFloatID:
+		var v []float32
+		return Val{VFloatID, v}
+
 	default:
 		return Val{}
 	}
@@ -19,8 +19,10 @@ import (
 type sortBase struct {
 	values [][]Val // Each uid could have multiple values which we need to sort it by.
 	desc   []bool  // Sort orders for different values.
-	ul     *intern.List
-	o      []*intern.Facets
+	// Whether null values come first for the different values. By default they're greatest.
+	nullsFirst []bool
+	ul         *intern.List
+	o          []*intern.Facets
 }
 
 // Len returns size of vector.
@@ -38,21 +40,45 @@ func (s sortBase) Swap(i, j int) {
 
 type byValue struct{ sortBase }
 
-// Less compares two elements
+// Less compares two elements. Elements with equal values are ordered by uid, so that the order
+// is the same whatever the order of the input.
 func (s byValue) Less(i, j int) bool {
 	first, second := s.values[i], s.values[j]
 	if len(first) == 0 || len(second) == 0 {
 		return false
 	}
-	for vidx, _ := range first {
+	if c := CompareSortValues(first, second, s.desc, s.nullsFirst); c != 0 {
+		return c < 0
+	}
+	return s.ul.Uids[i] < s.ul.Uids[j]
+}
+
+// CompareSortValues returns -1, 0 or 1 if the values first sort before, equal to or after the
+// values second, with the orders desc and the null values first or last as given by
+// nullsFirst. A nil nullsFirst has the null values greatest.
+func CompareSortValues(first, second []Val, desc, nullsFirst []bool) int {
+	for vidx := range first {
 		// Null value is considered greatest hence comes at first place while doing descending sort
-		// and at last place while doing ascending sort.
+		// and at last place while doing ascending sort, unless told otherwise.
+		nf := desc[vidx]
+		if nullsFirst != nil {
+			nf = nullsFirst[vidx]
+		}
+		if first[vidx].Value == nil && second[vidx].Value == nil {
+			continue
+		}
 		if first[vidx].Value == nil {
-			return s.desc[vidx]
+			if nf {
+				return -1
+			}
+			return 1
 		}
 
 		if second[vidx].Value == nil {
-			return !s.desc[vidx]
+			if nf {
+				return 1
+			}
+			return -1
 		}
 
 		// We have to look at next value to decide.
@@ -61,22 +87,43 @@ func (s byValue) Less(i, j int) bool {
 		}
 
 		// Its either less or greater.
-		less := less(first[vidx], second[vidx])
-		if s.desc[vidx] {
-			return !less
+		if less(first[vidx], second[vidx]) != desc[vidx] {
+			return -1
 		}
-		return less
+		return 1
 	}
-	return false
+	return 0
 }
 
 // Sort sorts the given array in-place.
 func SortWithFacet(v [][]Val, ul *intern.List, l []*intern.Facets, desc []bool) error {
-	if len(v) == 0 || len(v[0]) == 0 {
+	return sortValues(sortBase{v, desc, nil, ul, l})
+}
+
+// Sort sorts the given array in-place.
+func Sort(v [][]Val, ul *intern.List, desc []bool) error {
+	return SortWithFacet(v, ul, nil, desc)
+}
+
+// SortWithNulls sorts the given array in-place, with the null values first or last as given
+// by nullsFirst.
+func SortWithNulls(v [][]Val, ul *intern.List, desc, nullsFirst []bool) error {
+	return sortValues(sortBase{v, desc, nullsFirst, ul, nil})
+}
+
+func sortValues(b sortBase) error {
+	if len(b.values) == 0 || len(b.values[0]) == 0 {
 		return nil
 	}
 
-	typ := v[0][0].Tid
+	typ := b.values[0][0].Tid
+	// The first value might be null.
+	for _, v := range b.values {
+		if len(v) > 0 && v[0].Value != nil {
+			typ = v[0].Tid
+			break
+		}
+	}
 	switch typ {
 	case DateTimeID, IntID, FloatID, StringID, DefaultID:
 		// Don't do anything, we can sort values of this type.
@@ -84,17 +131,11 @@ func SortWithFacet(v [][]Val, ul *intern.List, l []*intern.Facets, desc []bool)
 		return fmt.Errorf("Value of type: %s isn't sortable.", typ.Name())
 	}
 	var toBeSorted sort.Interface
-	b := sortBase{v, desc, ul, l}
 	toBeSorted = byValue{b}
 	sort.Sort(toBeSorted)
 	return nil
 }
 
-// Sort sorts the given array in-place.
-func Sort(v [][]Val, ul *intern.List, desc []bool) error {
-	return SortWithFacet(v, ul, nil, desc)
-}
-
 // Less returns true if a is strictly less than b.
 func Less(a, b Val) (bool, error) {
 	if a.Tid != b.Tid {
@@ -61,6 +61,31 @@ func TestSortInts(t *testing.T) {
 		toString(t, list, IntID))
 }
 
+func TestSortWithNulls(t *testing.T) {
+	list := getInput(t, IntID, []string{"22", "11", "33"})
+	list = append(list[:1], append([][]Val{{{}}}, list[1:]...)...)
+	ul := getUIDList(4)
+	require.NoError(t, SortWithNulls(list, ul, []bool{false}, []bool{true}))
+	require.EqualValues(t, []uint64{200, 300, 100, 400}, ul.Uids)
+
+	require.NoError(t, SortWithNulls(list, ul, []bool{true}, []bool{false}))
+	require.EqualValues(t, []uint64{400, 100, 300, 200}, ul.Uids)
+}
+
+func TestSortEqualByUid(t *testing.T) {
+	list := getInput(t, IntID, []string{"22", "11", "22", "11"})
+	ul := &intern.List{Uids: []uint64{400, 300, 200, 100}}
+	require.NoError(t, Sort(list, ul, []bool{true}))
+	require.EqualValues(t, []uint64{200, 400, 100, 300}, ul.Uids)
+
+	a := []Val{{Tid: IntID, Value: int64(11)}, {}}
+	b := []Val{{Tid: IntID, Value: int64(11)}, {Tid: IntID, Value: int64(5)}}
+	require.Equal(t, 1, CompareSortValues(a, b, []bool{false, false}, nil))
+	require.Equal(t, -1, CompareSortValues(a, b, []bool{false, true}, nil))
+	require.Equal(t, -1, CompareSortValues(a, b, []bool{false, false}, []bool{true, true}))
+	require.Equal(t, 0, CompareSortValues(a, a, []bool{false, false}, nil))
+}
+
 func TestSortFloats(t *testing.T) {
 	list := getInput(t, FloatID, []string{"22.2", "11.2", "11.5", "2.12"})
 	ul := getUIDList(4)
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// ParseVFloat parses a vector written as a JSON array of numbers, e.g. "[0.1, 0.2, 0.3]".
+func ParseVFloat(s string) ([]float32, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return nil, x.Errorf("Invalid value for float32vector: %q. Expected [x, y, ...]", s)
+	}
+	s = strings.TrimSpace(s[1 : len(s)-1])
+	if len(s) == 0 {
+		return nil, x.Errorf("float32vector can't be empty")
+	}
+	parts := strings.Split(s, ",")
+	v := make([]float32, 0, len(parts))
+	for _, p := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(p), 32)
+		if err != nil {
+			return nil, x.Wrapf(err, "Invalid element in float32vector %q", s)
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, x.Errorf("Got invalid value: %v in float32vector", f)
+		}
+		v = append(v, float32(f))
+	}
+	return v, nil
+}
+
+// VFloatToString returns the vector as a JSON array.
+func VFloatToString(v []float32) string {
+	var b bytes.Buffer
+	b.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// VFloatToBytes encodes the vector as consecutive little endian float32s.
+func VFloatToBytes(v []float32) []byte {
+	b := make([]byte, 4*len(v))
+	for i, f := range v {
+		binary.LittleEndian.PutUint32(b[4*i:], math.Float32bits(f))
+	}
+	return b
+}
+
+// BytesToVFloat decodes a vector encoded by VFloatToBytes.
+func BytesToVFloat(b []byte) ([]float32, error) {
+	if len(b)%4 != 0 {
+		return nil, x.Errorf("Invalid data for float32vector of length %d", len(b))
+	}
+	v := make([]float32, len(b)/4)
+	for i := range v {
+		v[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+	}
+	return v, nil
+}
+
+// CosineDistance returns 1 minus the cosine similarity of the two vectors, which ranges from
+// 0 for vectors pointing the same way to 2 for opposite ones.
+func CosineDistance(a, b []float32) (float64, error) {
+	if len(a) != len(b) {
+		return 0, x.Errorf("Can't compare vectors of dimensions %d and %d", len(a), len(b))
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		// A zero vector has no direction, treat it as unrelated to everything.
+		return 1, nil
+	}
+	return 1 - dot/(math.Sqrt(na)*math.Sqrt(nb)), nil
+}
@@ -23,6 +23,12 @@ func CouldApplyAggregatorOn(agrtr string, typ types.TypeID) bool {
 	case "sum", "avg":
 		return (typ == types.IntID ||
 			typ == types.FloatID)
+	case "count_distinct":
+		return true
+	case "median", "percentile", "stddev", "variance":
+		return (typ == types.IntID ||
+			typ == types.FloatID ||
+			typ == types.DateTimeID)
 	default:
 		return false
 	}
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// taskBudget keeps track of the memory taken by the uid matrix and values built by a task, so
+// that the task fails once they go over what is left of the memory budget of the query.
+type taskBudget struct {
+	attr  string
+	limit uint64 // Zero if the query has no memory budget.
+	used  uint64
+}
+
+func newTaskBudget(q *intern.Query) *taskBudget {
+	return &taskBudget{attr: q.Attr, limit: q.MemoryBudget}
+}
+
+func (b *taskBudget) add(n uint64) error {
+	if b.limit == 0 {
+		return nil
+	}
+	b.used += n
+	if b.used > b.limit {
+		return x.Errorf("Query ran out of its memory budget while processing predicate %s: "+
+			"the results took more than the %d bytes left", b.attr, b.limit)
+	}
+	return nil
+}
+
+func (b *taskBudget) addUids(l *intern.List) error {
+	return b.add(8 * uint64(len(l.Uids)))
+}
+
+func (b *taskBudget) addValues(vl *intern.ValueList) error {
+	var n uint64
+	for _, v := range vl.Values {
+		n += uint64(len(v.Val)) + 8
+	}
+	return b.add(n)
+}
@@ -0,0 +1,216 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// changeLog keeps the edges of the transactions committed on this server, in the order the
+// commits were applied, for StreamChanges. Edges applied for transactions not yet decided are
+// kept pending by start timestamp.
+type changeLog struct {
+	sync.Mutex
+	// New every time the server starts, since the positions of the events start over.
+	id      uint64
+	pending map[uint64][]*intern.DirectedEdge
+	events  []*intern.ChangeEvent
+	// Position of events[0] in the log, and highest commit timestamp of the changes missing
+	// from it, either trimmed or committed before the server started.
+	first   uint64
+	dropped uint64
+	// Set once it's known which commits were applied before the server started.
+	started bool
+	// Closed and replaced whenever events are added.
+	added chan struct{}
+}
+
+var changes = newChangeLog()
+
+func newChangeLog() *changeLog {
+	return &changeLog{
+		id:      uint64(time.Now().UnixNano()),
+		pending: make(map[uint64][]*intern.DirectedEdge),
+		added:   make(chan struct{}),
+	}
+}
+
+// begin marks the start of the log. Commits applied before the server started aren't in the
+// log, and they all have timestamps up to maxTs, the highest timestamp Zero had handed out when
+// the server first heard from it.
+func (l *changeLog) begin(maxTs uint64) {
+	l.Lock()
+	defer l.Unlock()
+	if l.started {
+		return
+	}
+	l.started = true
+	if maxTs > l.dropped {
+		l.dropped = maxTs
+	}
+}
+
+// addEdge keeps the edge, once applied, until its transaction is decided.
+func (l *changeLog) addEdge(startTs uint64, edge *intern.DirectedEdge) {
+	if Config.ChangeLogSize <= 0 {
+		return
+	}
+	l.Lock()
+	defer l.Unlock()
+	l.pending[startTs] = append(l.pending[startTs], edge)
+}
+
+// drop forgets the edges of a transaction whose commit or abort failed to apply.
+func (l *changeLog) drop(startTs uint64) {
+	l.Lock()
+	defer l.Unlock()
+	delete(l.pending, startTs)
+}
+
+// decide moves the edges of the transaction to the log once its commit is applied, or drops
+// them if it was aborted.
+func (l *changeLog) decide(tctx *api.TxnContext) {
+	if tctx.CommitTs == 0 {
+		l.drop(tctx.StartTs)
+		return
+	}
+	l.Lock()
+	defer l.Unlock()
+	edges, ok := l.pending[tctx.StartTs]
+	if !ok {
+		return
+	}
+	delete(l.pending, tctx.StartTs)
+	for _, edge := range edges {
+		l.events = append(l.events, &intern.ChangeEvent{
+			Edge:     edge,
+			StartTs:  tctx.StartTs,
+			CommitTs: tctx.CommitTs,
+			LogId:    l.id,
+			Seq:      l.first + uint64(len(l.events)),
+		})
+	}
+	if extra := len(l.events) - Config.ChangeLogSize; extra > 0 {
+		for _, e := range l.events[:extra] {
+			if e.CommitTs > l.dropped {
+				l.dropped = e.CommitTs
+			}
+		}
+		l.events = append(l.events[:0:0], l.events[extra:]...)
+		l.first += uint64(extra)
+	}
+	close(l.added)
+	l.added = make(chan struct{})
+}
+
+// read returns the events from position pos onwards, the position following them, and a
+// channel closed once there are more.
+func (l *changeLog) read(pos uint64) ([]*intern.ChangeEvent, uint64, <-chan struct{}, error) {
+	l.Lock()
+	defer l.Unlock()
+	if pos < l.first {
+		return nil, 0, nil, x.Errorf("Change stream fell behind and lost changes committed"+
+			" before timestamp %d", l.dropped+1)
+	}
+	events := l.events[pos-l.first:]
+	return events, l.first + uint64(len(l.events)), l.added, nil
+}
+
+// start returns the position to stream the events requested from. A resumed stream continues
+// right after the event it last received. Otherwise, it starts from the first event committed
+// after since.
+func (l *changeLog) start(req *intern.ChangesRequest) (uint64, error) {
+	l.Lock()
+	defer l.Unlock()
+	if !l.started {
+		return 0, x.Errorf("Changes can't be streamed until this server has heard from Zero")
+	}
+	if req.LogId != 0 {
+		if req.LogId != l.id {
+			return 0, x.Errorf("Changes can't be resumed since the server restarted. The" +
+				" changes committed before it restarted aren't kept")
+		}
+		pos := req.AfterSeq + 1
+		if pos < l.first {
+			return 0, x.Errorf("Changes can't be resumed, the ones following the last one" +
+				" received are no longer kept")
+		}
+		if pos > l.first+uint64(len(l.events)) {
+			return 0, x.Errorf("Invalid position to resume changes from: %d", req.AfterSeq)
+		}
+		return pos, nil
+	}
+	since := req.SinceTs
+	if since != 0 && since < l.dropped {
+		return 0, x.Errorf("Changes committed after timestamp %d are no longer kept."+
+			" The oldest timestamp to resume from is %d", since, l.dropped)
+	}
+	pos := l.first
+	for _, e := range l.events {
+		if e.CommitTs > since {
+			break
+		}
+		pos++
+	}
+	return pos, nil
+}
+
+func (l *changeLog) stream(ctx context.Context, req *intern.ChangesRequest,
+	send func(*intern.ChangeEvent) error) error {
+	pos, err := l.start(req)
+	if err != nil {
+		return err
+	}
+	var since uint64
+	if req.LogId == 0 {
+		since = req.SinceTs
+	}
+	for {
+		events, next, added, err := l.read(pos)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			// Commits aren't always applied in timestamp order.
+			if e.CommitTs <= since {
+				continue
+			}
+			if err := send(e); err != nil {
+				return err
+			}
+		}
+		pos = next
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-added:
+		}
+	}
+}
+
+// StreamChanges calls send with every edge set or deleted by the transactions committed on
+// this server after the commit timestamp req.SinceTs, first the ones it still keeps and then
+// the new ones as they are committed. Zero SinceTs starts from the oldest change kept. Changes
+// committed before the server started aren't kept. Commits aren't always applied in timestamp
+// order, so a stream is resumed by the position of the last event received rather than by its
+// commit timestamp. It returns once the context is done, send fails, or the changes to send are
+// no longer kept.
+func StreamChanges(ctx context.Context, req *intern.ChangesRequest,
+	send func(*intern.ChangeEvent) error) error {
+	if Config.ChangeLogSize <= 0 {
+		return x.Errorf("Change data capture is disabled on this server")
+	}
+	return changes.stream(ctx, req, send)
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgo/protos/api"
+	"github.com/dgraph-io/dgraph/protos/intern"
+)
+
+func addChanges(l *changeLog, startTs uint64, attrs ...string) {
+	for _, attr := range attrs {
+		l.addEdge(startTs, &intern.DirectedEdge{Entity: 1, Attr: attr, ValueId: 2})
+	}
+}
+
+func TestChangeLog(t *testing.T) {
+	size := Config.ChangeLogSize
+	defer func() { Config.ChangeLogSize = size }()
+	Config.ChangeLogSize = 3
+
+	l := newChangeLog()
+	l.begin(0)
+	addChanges(l, 10, "name", "age")
+	addChanges(l, 12, "friend")
+	addChanges(l, 14, "name")
+	l.decide(&api.TxnContext{StartTs: 12})
+	l.decide(&api.TxnContext{StartTs: 14, CommitTs: 15})
+	l.decide(&api.TxnContext{StartTs: 10, CommitTs: 11})
+	require.Empty(t, l.pending)
+	// The edges of a transaction whose commit failed to apply aren't kept either.
+	addChanges(l, 30, "name")
+	l.drop(30)
+	require.Empty(t, l.pending)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var commits []uint64
+	var attrs []string
+	err := l.stream(ctx, &intern.ChangesRequest{}, func(e *intern.ChangeEvent) error {
+		commits = append(commits, e.CommitTs)
+		attrs = append(attrs, e.Edge.Attr)
+		if len(commits) == 3 {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{15, 11, 11}, commits)
+	require.Equal(t, []string{"name", "name", "age"}, attrs)
+
+	// Resuming skips the changes committed up to the timestamp, even if applied later.
+	commits = commits[:0]
+	ctx, cancel = context.WithCancel(context.Background())
+	err = l.stream(ctx, &intern.ChangesRequest{SinceTs: 14}, func(e *intern.ChangeEvent) error {
+		commits = append(commits, e.CommitTs)
+		switch e.CommitTs {
+		case 15:
+			addChanges(l, 16, "age")
+			l.decide(&api.TxnContext{StartTs: 16, CommitTs: 17})
+		case 17:
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{15, 17}, commits)
+
+	// The change committed at 15 was trimmed from the log.
+	err = l.stream(context.Background(), &intern.ChangesRequest{SinceTs: 12},
+		func(e *intern.ChangeEvent) error { return nil })
+	require.Error(t, err)
+}
+
+func TestChangeLogBegin(t *testing.T) {
+	size := Config.ChangeLogSize
+	defer func() { Config.ChangeLogSize = size }()
+	Config.ChangeLogSize = 3
+
+	l := newChangeLog()
+	addChanges(l, 20, "name")
+	l.decide(&api.TxnContext{StartTs: 20, CommitTs: 21})
+	send := func(e *intern.ChangeEvent) error { return nil }
+	// It isn't known yet which changes were committed before the server started.
+	require.Error(t, l.stream(context.Background(), &intern.ChangesRequest{}, send))
+
+	l.begin(18)
+	// Only the first oracle delta marks the start of the log.
+	l.begin(30)
+	require.Error(t, l.stream(context.Background(), &intern.ChangesRequest{SinceTs: 17}, send))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var commits []uint64
+	err := l.stream(ctx, &intern.ChangesRequest{SinceTs: 18}, func(e *intern.ChangeEvent) error {
+		commits = append(commits, e.CommitTs)
+		cancel()
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{21}, commits)
+}
+
+func TestChangeLogResume(t *testing.T) {
+	size := Config.ChangeLogSize
+	defer func() { Config.ChangeLogSize = size }()
+	Config.ChangeLogSize = 3
+
+	l := newChangeLog()
+	l.begin(0)
+	addChanges(l, 8, "name")
+	addChanges(l, 9, "age")
+	l.decide(&api.TxnContext{StartTs: 9, CommitTs: 11})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var last *intern.ChangeEvent
+	err := l.stream(ctx, &intern.ChangesRequest{}, func(e *intern.ChangeEvent) error {
+		last = e
+		cancel()
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), last.CommitTs)
+
+	// The commit at 10 is applied after the one at 11 was received, and is still streamed
+	// when resuming after it.
+	l.decide(&api.TxnContext{StartTs: 8, CommitTs: 10})
+	ctx, cancel = context.WithCancel(context.Background())
+	var commits []uint64
+	resume := &intern.ChangesRequest{LogId: last.LogId, AfterSeq: last.Seq}
+	err = l.stream(ctx, resume, func(e *intern.ChangeEvent) error {
+		commits = append(commits, e.CommitTs)
+		cancel()
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10}, commits)
+
+	// The log of a restarted server can't be resumed from.
+	send := func(e *intern.ChangeEvent) error { return nil }
+	err = l.stream(context.Background(),
+		&intern.ChangesRequest{LogId: last.LogId + 1, AfterSeq: last.Seq}, send)
+	require.Error(t, err)
+
+	// Nor can the changes which were trimmed.
+	for ts := uint64(12); ts < 18; ts += 2 {
+		addChanges(l, ts, "name")
+		l.decide(&api.TxnContext{StartTs: ts, CommitTs: ts + 1})
+	}
+	require.Error(t, l.stream(context.Background(), resume, send))
+}
@@ -6,7 +6,10 @@
  */
 package worker
 
-import "net"
+import (
+	"net"
+	"time"
+)
 
 type IPRange struct {
 	Lower, Upper net.IP
@@ -23,6 +26,8 @@ type Options struct {
 	RaftId              uint64
 	ExpandEdge          bool
 	WhiteListedIPRanges []IPRange
+	ChangeLogSize       int
+	HistoryRetention    time.Duration
 }
 
 var Config Options
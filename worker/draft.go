@@ -335,6 +335,7 @@ func (n *node) processMutation(task *task) error {
 		}
 		return err
 	}
+	changes.addEdge(txn.StartTs, edge)
 	return nil
 }
 
@@ -446,8 +447,12 @@ func (n *node) commitOrAbort(index uint64, pid string, tctx *api.TxnContext) {
 		tr.LazyPrintf("Status of commitOrAbort %+v %v\n", tctx, err)
 	}
 	if err == nil {
+		changes.decide(tctx)
 		posting.Txns().Done(tctx.StartTs)
 		posting.Oracle().Done(tctx.StartTs)
+	} else {
+		// Otherwise the edges would be kept pending forever.
+		changes.drop(tctx.StartTs)
 	}
 	posting.TxnMarks().Done(index)
 	n.props.Done(pid, err)
@@ -27,6 +27,7 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/intern"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
@@ -43,6 +44,7 @@ type kv struct {
 type skv struct {
 	attr   string
 	schema *intern.SchemaUpdate
+	typ    *intern.TypeUpdate
 }
 
 // Map from our types to RDF type. Useful when writing storage types
@@ -57,6 +59,7 @@ var rdfTypeMap = map[types.TypeID]string{
 	types.GeoID:      "geo:geojson",
 	types.BinaryID:   "xs:base64Binary",
 	types.PasswordID: "xs:string",
+	types.VFloatID:   "xs:string",
 }
 
 func toRDF(buf *bytes.Buffer, item kv, readTs uint64) {
@@ -147,7 +150,15 @@ func toSchema(buf *bytes.Buffer, s *skv) {
 		buf.WriteString(" @reverse")
 	} else if s.schema.Directive == intern.SchemaUpdate_INDEX && len(s.schema.Tokenizer) > 0 {
 		buf.WriteString(" @index(")
-		buf.WriteString(strings.Join(s.schema.Tokenizer, ","))
+		for i, t := range s.schema.Tokenizer {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(t)
+			if t == tok.FtsTokenizerName("") && s.schema.Analyzer != nil {
+				toAnalyzerConfig(buf, s.schema.Analyzer)
+			}
+		}
 		buf.WriteByte(')')
 	}
 	if s.schema.Count {
@@ -156,6 +167,54 @@ func toSchema(buf *bytes.Buffer, s *skv) {
 	buf.WriteString(" . \n")
 }
 
+// toAnalyzerConfig writes the options of a full text index in the schema syntax.
+func toAnalyzerConfig(buf *bytes.Buffer, cfg *intern.AnalyzerConfig) {
+	var opts []string
+	if len(cfg.Lang) > 0 {
+		opts = append(opts, "lang: "+strconv.Quote(cfg.Lang))
+	}
+	if cfg.CustomStopwords {
+		words := make([]string, 0, len(cfg.Stopwords))
+		for _, w := range cfg.Stopwords {
+			words = append(words, strconv.Quote(w))
+		}
+		opts = append(opts, "stopwords: ["+strings.Join(words, ", ")+"]")
+	}
+	if len(cfg.Synonyms) > 0 {
+		// The synonyms file might not be there where the export is loaded, so the synonyms
+		// are written inline.
+		groups := make([]string, 0, len(cfg.Synonyms))
+		for _, g := range cfg.Synonyms {
+			groups = append(groups, strconv.Quote(g))
+		}
+		opts = append(opts, "synonyms: ["+strings.Join(groups, ", ")+"]")
+	}
+	if cfg.NoStem {
+		opts = append(opts, "stem: false")
+	}
+	buf.WriteByte('(')
+	buf.WriteString(strings.Join(opts, ", "))
+	buf.WriteByte(')')
+}
+
+func toType(buf *bytes.Buffer, t *intern.TypeUpdate) {
+	buf.WriteString("type ")
+	buf.WriteString(t.TypeName)
+	buf.WriteString(" {\n")
+	for _, field := range t.Fields {
+		buf.WriteByte('\t')
+		if strings.ContainsRune(field, ':') {
+			buf.WriteRune('<')
+			buf.WriteString(field)
+			buf.WriteRune('>')
+		} else {
+			buf.WriteString(field)
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("}\n")
+}
+
 func writeToFile(fpath string, ch chan []byte) error {
 	f, err := os.Create(fpath)
 	if err != nil {
@@ -246,7 +305,11 @@ func export(bdir string, readTs uint64) error {
 		buf := new(bytes.Buffer)
 		buf.Grow(50000)
 		for item := range chs {
-			toSchema(buf, item)
+			if item.typ != nil {
+				toType(buf, item.typ)
+			} else {
+				toSchema(buf, item)
+			}
 			if buf.Len() >= 40000 {
 				tmp := make([]byte, buf.Len())
 				copy(tmp, buf.Bytes())
@@ -284,6 +347,21 @@ func export(bdir string, readTs uint64) error {
 			continue
 		}
 
+		if pk.IsTypeDef() {
+			// Every group has all the type definitions, so only one of them exports them.
+			if gid == 1 {
+				t := &intern.TypeUpdate{}
+				val, err := item.Value()
+				if err != nil {
+					return err
+				}
+				x.Check(t.Unmarshal(val))
+				chs <- &skv{typ: t}
+			}
+			it.Next()
+			continue
+		}
+
 		if pk.IsIndex() || pk.IsReverse() || pk.IsCount() {
 			// Seek to the end of index, reverse and count keys.
 			it.Seek(pk.SkipRangeOfSameType())
@@ -307,6 +385,11 @@ func export(bdir string, readTs uint64) error {
 		}
 
 		if pk.IsSchema() {
+			if pk.Attr == x.TypeAttr {
+				// Schema for _type_ is set by the server itself.
+				it.Next()
+				continue
+			}
 			s := &intern.SchemaUpdate{}
 			val, err := item.Value()
 			if err != nil {
@@ -9,6 +9,7 @@ package worker
 
 import (
 	"bufio"
+	"bytes"
 	"compress/gzip"
 	"io/ioutil"
 	"math"
@@ -220,6 +221,48 @@ func TestExport(t *testing.T) {
 	require.Equal(t, 1, count)
 }
 
+func TestExportType(t *testing.T) {
+	typ := &intern.TypeUpdate{
+		TypeName: "Person",
+		Fields:   []string{"name", "http://schema.org/knows"},
+	}
+	var buf bytes.Buffer
+	toType(&buf, typ)
+	require.Equal(t, "type Person {\n\tname\n\t<http://schema.org/knows>\n}\n", buf.String())
+
+	_, types, err := schema.ParseWithTypes(buf.String())
+	require.NoError(t, err)
+	require.Equal(t, []*intern.TypeUpdate{typ}, types)
+}
+
+func TestExportFullTextOptions(t *testing.T) {
+	su := &intern.SchemaUpdate{
+		Predicate: "description",
+		ValueType: intern.Posting_STRING,
+		Directive: intern.SchemaUpdate_INDEX,
+		Tokenizer: []string{"fulltext", "term"},
+		Analyzer: &intern.AnalyzerConfig{
+			Lang:            "de",
+			Stopwords:       []string{"und", "oder"},
+			CustomStopwords: true,
+			SynonymsFile:    "/path/to/synonyms.txt",
+			Synonyms:        []string{"auto,wagen", "schnell,rasch"},
+			NoStem:          true,
+		},
+	}
+	var buf bytes.Buffer
+	toSchema(&buf, &skv{attr: su.Predicate, schema: su})
+	require.Equal(t, "description:string @index(fulltext(lang: \"de\", "+
+		"stopwords: [\"und\", \"oder\"], synonyms: [\"auto,wagen\", \"schnell,rasch\"], "+
+		"stem: false),term) . \n", buf.String())
+
+	// The synonyms are loaded from the export, not from the file.
+	schemas, err := schema.Parse(buf.String())
+	require.NoError(t, err)
+	su.Analyzer.SynonymsFile = ""
+	require.Equal(t, []*intern.SchemaUpdate{su}, schemas)
+}
+
 // func generateBenchValues() []kv {
 // 	byteInt := make([]byte, 4)
 // 	binary.LittleEndian.PutUint32(byteInt, 123)
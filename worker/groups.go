@@ -109,6 +109,7 @@ func StartRaftNodes(walStore *badger.ManagedDB, bindall bool) {
 	// not get any data.
 	posting.Oracle().SetMaxPending(connState.MaxPending)
 	gr.applyState(connState.GetState())
+	gr.updateHistoryTs(true)
 
 	gr.wal = raftwal.Init(walStore, Config.RaftId)
 	gr.triggerCh = make(chan struct{}, 1)
@@ -123,29 +124,40 @@ func StartRaftNodes(walStore *badger.ManagedDB, bindall bool) {
 	go gr.periodicMembershipUpdate() // Now set it to be run periodically.
 	go gr.cleanupTablets()
 	go gr.processOracleDeltaStream()
+	go gr.retainHistory()
 	gr.proposeInitialSchema()
 }
 
 func (g *groupi) proposeInitialSchema() {
-	if !Config.ExpandEdge {
-		return
-	}
 	g.RLock()
-	_, ok := g.tablets[x.PredicateListAttr]
+	_, hasPredicateList := g.tablets[x.PredicateListAttr]
+	_, hasType := g.tablets[x.TypeAttr]
 	g.RUnlock()
-	if ok {
-		return
-	}
 
 	// Propose schema mutation.
 	var m intern.Mutations
-	// schema for _predicate_ is not changed once set.
+	// schema for _predicate_ and _type_ is not changed once set.
 	m.StartTs = 1
-	m.Schema = append(m.Schema, &intern.SchemaUpdate{
-		Predicate: x.PredicateListAttr,
-		ValueType: intern.Posting_STRING,
-		List:      true,
-	})
+	if Config.ExpandEdge && !hasPredicateList {
+		m.Schema = append(m.Schema, &intern.SchemaUpdate{
+			Predicate: x.PredicateListAttr,
+			ValueType: intern.Posting_STRING,
+			List:      true,
+		})
+	}
+	// _type_ is indexed so that nodes can be looked up by their type.
+	if !hasType {
+		m.Schema = append(m.Schema, &intern.SchemaUpdate{
+			Predicate: x.TypeAttr,
+			ValueType: intern.Posting_STRING,
+			Directive: intern.SchemaUpdate_INDEX,
+			Tokenizer: []string{"exact"},
+			List:      true,
+		})
+	}
+	if len(m.Schema) == 0 {
+		return
+	}
 
 	// This would propose the schema mutation and make sure some node serves this predicate
 	// and has the schema defined above.
@@ -182,7 +194,8 @@ func (g *groupi) calculateTabletSizes() map[string]*intern.Tablet {
 		item := itr.Item()
 
 		pk := x.Parse(item.Key())
-		if pk == nil {
+		// Type definitions are present in every group and don't belong to any tablet.
+		if pk == nil || pk.IsTypeDef() {
 			itr.Next()
 			continue
 		}
@@ -613,7 +626,7 @@ func (g *groupi) cleanupTablets() {
 
 				// TODO: Investiage out of bounds.
 				pk := x.Parse(item.Key())
-				if pk == nil {
+				if pk == nil || pk.IsTypeDef() {
 					itr.Next()
 					continue
 				}
@@ -694,6 +707,45 @@ func (g *groupi) proposeDelta(oracleDelta *intern.OracleDelta) {
 	}
 }
 
+// updateHistoryTs sets the lowest timestamp posting lists can be read at to the one Zero handed
+// out when the history retention window started. Initially, if Zero can't tell, history starts
+// at the latest timestamp. Later, if Zero can't tell, as after it changed leader, history is kept
+// from the last known start until it can.
+func (g *groupi) updateHistoryTs(initial bool) {
+	if Config.HistoryRetention <= 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(g.ctx, 10*time.Second)
+	defer cancel()
+	ts, err := TimestampAt(ctx, time.Now().Add(-Config.HistoryRetention))
+	switch {
+	case err == nil && (initial || ts > posting.HistoryTs()):
+		posting.SetHistoryTs(ts)
+	case err != nil && initial:
+		x.Printf("Unable to find the start of history retention: %v\n", err)
+		posting.SetHistoryTs(posting.Oracle().MaxPending())
+	case err != nil:
+		x.Printf("Unable to move the start of history retention past %d: %v\n",
+			posting.HistoryTs(), err)
+	}
+}
+
+func (g *groupi) retainHistory() {
+	if Config.HistoryRetention <= 0 {
+		return
+	}
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-g.ctx.Done():
+			return
+		case <-ticker.C:
+			g.updateHistoryTs(false)
+		}
+	}
+}
+
 // processOracleDeltaStream is used to process oracle delta stream from Zero.
 // Zero sends information about aborted/committed transactions and maxPending.
 func (g *groupi) processOracleDeltaStream() {
@@ -735,6 +787,7 @@ START:
 			break
 		}
 		posting.Oracle().ProcessOracleDelta(oracleDelta)
+		changes.begin(oracleDelta.MaxPending)
 		// Do Immediately so that index keys are written.
 		g.proposeDelta(oracleDelta)
 	}
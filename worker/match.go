@@ -0,0 +1,207 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"errors"
+	"sort"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+var matchTooWideErr = errors.New("Fuzzy match is too wide-ranging and can't be executed efficiently.")
+
+// levenshteinDistance returns the minimum number of single character insertions, deletions
+// and substitutions needed to turn a into b.
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// minSharedTrigrams returns how many distinct trigrams of text a string within maxDistance
+// edits of it must still contain. Deleting or substituting a rune that is w bytes wide breaks
+// at most w+2 trigrams and an insertion breaks at most two.
+func minSharedTrigrams(text string, numTrigrams, maxDistance int) int {
+	width := 1
+	for _, r := range text {
+		if l := utf8.RuneLen(r); l > width {
+			width = l
+		}
+	}
+	if min := numTrigrams - maxDistance*(width+2); min > 1 {
+		return min
+	}
+	// Strings sharing no trigram with the text can't be found using the index.
+	return 1
+}
+
+// uidsForMatch returns the uids whose values share enough trigrams with the text of the match
+// function to possibly be within its max distance. If intersect is not empty, only uids in it
+// are considered.
+func uidsForMatch(attr string, arg funcArgs, intersect *intern.List) (*intern.List, error) {
+	text := arg.srcFn.matchText
+	trigrams, err := tok.BuildTokens(text, tok.TrigramTokenizer{})
+	if err != nil {
+		return nil, err
+	}
+	if len(trigrams) == 0 {
+		return nil, x.Errorf("match needs a text of at least 3 characters, but got: %q", text)
+	}
+
+	opts := posting.ListOptions{
+		ReadTs: arg.q.ReadTs,
+	}
+	if intersect.Size() > 0 {
+		opts.Intersect = intersect
+	}
+
+	counts := make(map[uint64]int)
+	for _, t := range trigrams {
+		arg.out.IndexKeys++
+		pl, err := posting.Get(x.IndexKey(attr, t))
+		if err != nil {
+			return nil, err
+		}
+		uids, err := pl.Uids(opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, uid := range uids.Uids {
+			counts[uid]++
+		}
+		if len(counts) > maxUidsForTrigram {
+			return nil, matchTooWideErr
+		}
+	}
+
+	min := minSharedTrigrams(text, len(trigrams), arg.srcFn.maxDistance)
+	result := &intern.List{}
+	for uid, c := range counts {
+		if c >= min {
+			result.Uids = append(result.Uids, uid)
+		}
+	}
+	sort.Slice(result.Uids, func(i, j int) bool { return result.Uids[i] < result.Uids[j] })
+	return result, nil
+}
+
+func matchFuzzy(value types.Val, srcFn *functionContext) bool {
+	s := value.Value.(string)
+	return len(s) > 0 && levenshteinDistance(s, srcFn.matchText) <= srcFn.maxDistance
+}
+
+func handleMatchFunction(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	typ, err := schema.State().TypeOf(attr)
+	if err != nil || !typ.IsScalar() {
+		return x.Errorf("Attribute not scalar: %s %v", attr, typ)
+	}
+	if typ != types.StringID {
+		return x.Errorf("Got non-string type. Fuzzy match is allowed only on string type.")
+	}
+	var found bool
+	for _, t := range schema.State().TokenizerNames(attr) {
+		if t == "trigram" {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return x.Errorf("Attribute %v does not have trigram index for fuzzy matching.", attr)
+	}
+
+	intersect := &intern.List{}
+	if arg.q.UidList != nil {
+		intersect = arg.q.UidList
+	}
+	uids, err := uidsForMatch(attr, arg, intersect)
+	if err != nil {
+		return err
+	}
+
+	isList := schema.State().IsList(attr)
+	lang := langForFunc(arg.q.Langs)
+	filtered := &intern.List{}
+	for _, uid := range uids.Uids {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		pl, err := posting.Get(x.DataKey(attr, uid))
+		if err != nil {
+			return err
+		}
+
+		var vals []types.Val
+		if lang != "" {
+			var val types.Val
+			val, err = pl.ValueForTag(arg.q.ReadTs, lang)
+			vals = append(vals, val)
+		} else if isList {
+			vals, err = pl.AllUntaggedValues(arg.q.ReadTs)
+		} else {
+			var val types.Val
+			val, err = pl.Value(arg.q.ReadTs)
+			vals = append(vals, val)
+		}
+		if err == posting.ErrNoValue {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		for _, val := range vals {
+			// convert data from binary to appropriate format
+			strVal, err := types.Convert(val, types.StringID)
+			if err == nil && matchFuzzy(strVal, arg.srcFn) {
+				filtered.Uids = append(filtered.Uids, uid)
+				break
+			}
+		}
+	}
+	arg.out.UidMatrix = append(arg.out.UidMatrix, filtered)
+	return nil
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevenshteinDistance(t *testing.T) {
+	for _, test := range []struct {
+		a, b string
+		dist int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"Rick Grimes", "Rick Grimez", 1},
+		{"Rick Grimes", "Rik Grimez", 2},
+		{"Барсук", "Барсуг", 1},
+		{"flaw", "lawn", 2},
+	} {
+		require.Equal(t, test.dist, levenshteinDistance(test.a, test.b), "%q %q", test.a, test.b)
+		require.Equal(t, test.dist, levenshteinDistance(test.b, test.a), "%q %q", test.b, test.a)
+	}
+}
+
+func TestMinSharedTrigrams(t *testing.T) {
+	require.Equal(t, 6, minSharedTrigrams("Rick Grimes", 9, 1))
+	require.Equal(t, 1, minSharedTrigrams("Rick", 2, 1))
+	require.Equal(t, 6, minSharedTrigrams("Барсук", 10, 1))
+	require.Equal(t, 9, minSharedTrigrams("Rick Grimes", 9, 0))
+}
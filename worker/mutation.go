@@ -27,6 +27,7 @@ import (
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
+	"github.com/gogo/protobuf/proto"
 )
 
 var (
@@ -203,6 +204,10 @@ func needReindexing(old intern.SchemaUpdate, current intern.SchemaUpdate) bool {
 			return true
 		}
 	}
+	// if the full text analyzer config has changed
+	if !proto.Equal(current.Analyzer, old.Analyzer) {
+		return true
+	}
 
 	return false
 }
@@ -221,6 +226,19 @@ func updateSchema(attr string, s intern.SchemaUpdate, index uint64) error {
 	return txn.CommitAt(1, nil)
 }
 
+// updateType sets the definition of the given type in memory and writes it to disk.
+func updateType(typ intern.TypeUpdate) error {
+	schema.State().SetType(typ)
+	txn := pstore.NewTransactionAt(1, true)
+	defer txn.Discard()
+	data, err := typ.Marshal()
+	x.Check(err)
+	if err := txn.Set(x.TypeKey(typ.TypeName), data); err != nil {
+		return err
+	}
+	return txn.CommitAt(1, nil)
+}
+
 func updateSchemaType(attr string, typ types.TypeID, index uint64) {
 	// Don't overwrite schema blindly, acl's might have been set even though
 	// type is not present
@@ -397,6 +415,22 @@ func Timestamps(ctx context.Context, num *intern.Num) (*api.AssignedIds, error)
 	return c.Timestamps(ctx, num)
 }
 
+// TimestampAt asks Zero for the highest timestamp handed out by time t.
+func TimestampAt(ctx context.Context, t time.Time) (uint64, error) {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return 0, conn.ErrNoConnection
+	}
+
+	conn := pl.Get()
+	c := intern.NewZeroClient(conn)
+	num, err := c.TimestampAt(ctx, &intern.Time{UnixNano: t.UnixNano()})
+	if err != nil {
+		return 0, err
+	}
+	return num.Val, nil
+}
+
 func fillTxnContext(tctx *api.TxnContext, gid uint32, startTs uint64) {
 	node := groups().Node
 	var index uint64
@@ -482,6 +516,18 @@ func populateMutationMap(src *intern.Mutations) map[uint32]*intern.Mutations {
 		}
 		mu.Schema = append(mu.Schema, schema)
 	}
+	// Type definitions are needed by whichever server coordinates a query, so
+	// every group keeps a copy of them.
+	if len(src.Types) > 0 {
+		for _, gid := range groups().KnownGroups() {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &intern.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Types = src.Types
+		}
+	}
 	if src.DropAll {
 		for _, gid := range groups().KnownGroups() {
 			mu := mm[gid]
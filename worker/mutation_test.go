@@ -207,4 +207,14 @@ func TestNeedReindexing(t *testing.T) {
 	s1 = intern.SchemaUpdate{ValueType: intern.Posting_STRING, Directive: intern.SchemaUpdate_INDEX, Tokenizer: []string{"exact"}}
 	s2 = intern.SchemaUpdate{ValueType: intern.Posting_FLOAT, Directive: intern.SchemaUpdate_NONE}
 	require.True(t, needReindexing(s1, s2))
+
+	s1 = intern.SchemaUpdate{ValueType: intern.Posting_STRING, Directive: intern.SchemaUpdate_INDEX, Tokenizer: []string{"fulltext"}, Analyzer: &intern.AnalyzerConfig{NoStem: true}}
+	s2 = intern.SchemaUpdate{ValueType: intern.Posting_STRING, Directive: intern.SchemaUpdate_INDEX, Tokenizer: []string{"fulltext"}, Analyzer: &intern.AnalyzerConfig{NoStem: true}}
+	require.False(t, needReindexing(s1, s2))
+
+	s2 = intern.SchemaUpdate{ValueType: intern.Posting_STRING, Directive: intern.SchemaUpdate_INDEX, Tokenizer: []string{"fulltext"}, Analyzer: &intern.AnalyzerConfig{Stopwords: []string{"a"}, CustomStopwords: true}}
+	require.True(t, needReindexing(s1, s2))
+
+	s2 = intern.SchemaUpdate{ValueType: intern.Posting_STRING, Directive: intern.SchemaUpdate_INDEX, Tokenizer: []string{"fulltext"}}
+	require.True(t, needReindexing(s1, s2))
 }
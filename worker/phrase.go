@@ -0,0 +1,213 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func containsPosition(positions []uint32, pos int64) bool {
+	i := sort.Search(len(positions), func(i int) bool { return int64(positions[i]) >= pos })
+	return i < len(positions) && int64(positions[i]) == pos
+}
+
+// phraseAt tells whether the words of the phrase occur in the same order and at the same
+// distances from each other as in the phrase.
+func phraseAt(phrase []tok.TextToken, positions map[string][]uint32) bool {
+	first := phrase[0]
+	for _, p := range positions[first.Token] {
+		start := int64(p) - int64(first.Pos)
+		found := true
+		for _, t := range phrase[1:] {
+			if !containsPosition(positions[t.Token], start+int64(t.Pos)) {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// wordsNear tells whether every word occurs, in any order, within a window spanning at most
+// distance words.
+func wordsNear(words []tok.TextToken, positions map[string][]uint32, distance int) bool {
+	for _, w := range words {
+		for _, start := range positions[w.Token] {
+			found := true
+			for _, other := range words {
+				pos := positions[other.Token]
+				i := sort.Search(len(pos), func(i int) bool { return pos[i] >= start })
+				if i == len(pos) || int64(pos[i])-int64(start) > int64(distance) {
+					found = false
+					break
+				}
+			}
+			if found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchPositions(srcFn *functionContext, positions map[string][]uint32) bool {
+	if srcFn.fname == "near_words" {
+		return wordsNear(srcFn.textTokens, positions, srcFn.nearDistance)
+	}
+	return phraseAt(srcFn.textTokens, positions)
+}
+
+// uidsForPhrase returns the uids that have all the words of the function in their full text
+// index, with the positions of the words as recorded in the index postings. The positions are
+// nil for postings indexed without them.
+func uidsForPhrase(attr string, arg funcArgs) (*intern.List, map[uint64]map[string][]uint32,
+	error) {
+	opts := posting.ListOptions{ReadTs: arg.q.ReadTs}
+	var uids *intern.List
+	positions := make(map[uint64]map[string][]uint32)
+	seen := make(map[string]bool)
+	for _, t := range arg.srcFn.textTokens {
+		if seen[t.Token] {
+			continue
+		}
+		seen[t.Token] = true
+
+		arg.out.IndexKeys++
+		pl, err := posting.Get(x.IndexKey(attr, t.Token))
+		if err != nil {
+			return nil, nil, err
+		}
+		l := &intern.List{}
+		var perr error
+		err = pl.Postings(opts, func(p *intern.Posting) bool {
+			if arg.q.UidList != nil && algo.IndexOf(arg.q.UidList, p.Uid) < 0 {
+				return true
+			}
+			l.Uids = append(l.Uids, p.Uid)
+			var pos []uint32
+			if pos, perr = tok.DecodePositions(p.Positions); perr != nil {
+				return false
+			}
+			if positions[p.Uid] == nil {
+				positions[p.Uid] = make(map[string][]uint32)
+			}
+			positions[p.Uid][t.Token] = pos
+			return true
+		})
+		if perr != nil {
+			return nil, nil, perr
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if uids == nil {
+			uids = l
+		} else {
+			algo.IntersectWith(uids, l, uids)
+		}
+		if len(uids.Uids) == 0 {
+			break
+		}
+	}
+	return uids, positions, nil
+}
+
+// handlePhraseFunction finds the nodes matching phrase or near_words. The positions recorded in
+// the full text index decide the match, unless the value they were recorded for may not be the
+// one the function looks at: with language tags or list values, or if the value was indexed
+// without positions, the values are tokenized again and matched instead.
+func handlePhraseFunction(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	lang := langForFunc(arg.q.Langs)
+	tokenizer, err := FullTextTokenizer(attr, lang)
+	if err != nil {
+		return err
+	}
+	uids, positions, err := uidsForPhrase(attr, arg)
+	if err != nil {
+		return err
+	}
+
+	isList := schema.State().IsList(attr)
+	useIndex := !isList && !schema.State().HasLang(attr)
+	filtered := &intern.List{}
+	for _, uid := range uids.Uids {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if useIndex && indexedWithPositions(positions[uid]) {
+			if matchPositions(arg.srcFn, positions[uid]) {
+				filtered.Uids = append(filtered.Uids, uid)
+			}
+			continue
+		}
+
+		pl, err := posting.Get(x.DataKey(attr, uid))
+		if err != nil {
+			return err
+		}
+		var vals []types.Val
+		if lang != "" && lang != "." {
+			var val types.Val
+			val, err = pl.ValueForTag(arg.q.ReadTs, lang)
+			vals = append(vals, val)
+		} else if isList {
+			vals, err = pl.AllUntaggedValues(arg.q.ReadTs)
+		} else {
+			var val types.Val
+			val, err = pl.Value(arg.q.ReadTs)
+			vals = append(vals, val)
+		}
+		if err == posting.ErrNoValue {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		for _, val := range vals {
+			strVal, err := types.Convert(val, types.StringID)
+			if err != nil {
+				continue
+			}
+			tokens, err := tok.TokenPositions(tokenizer, strVal.Value.(string))
+			if err != nil {
+				return err
+			}
+			if matchPositions(arg.srcFn, tok.PositionsByToken(tokens)) {
+				filtered.Uids = append(filtered.Uids, uid)
+				break
+			}
+		}
+	}
+	arg.out.UidMatrix = append(arg.out.UidMatrix, filtered)
+	return nil
+}
+
+func indexedWithPositions(positions map[string][]uint32) bool {
+	for _, p := range positions {
+		if len(p) == 0 {
+			return false
+		}
+	}
+	return true
+}
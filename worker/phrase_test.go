@@ -0,0 +1,50 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/tok"
+)
+
+func textPositions(t *testing.T, text string) map[string][]uint32 {
+	return tok.PositionsByToken(textTokens(t, text))
+}
+
+func textTokens(t *testing.T, text string) []tok.TextToken {
+	tokenizer, has := tok.GetTokenizer("fulltext")
+	require.True(t, has)
+	tokens, err := tok.TokenPositions(tokenizer, text)
+	require.NoError(t, err)
+	return tokens
+}
+
+func TestPhraseAt(t *testing.T) {
+	positions := textPositions(t, "From Baker Street to the city of Sherlock Holmes")
+	require.True(t, phraseAt(textTokens(t, "sherlock holmes"), positions))
+	require.True(t, phraseAt(textTokens(t, "city of sherlock holmes"), positions))
+	require.True(t, phraseAt(textTokens(t, "baker"), positions))
+	require.False(t, phraseAt(textTokens(t, "holmes sherlock"), positions))
+	require.False(t, phraseAt(textTokens(t, "baker sherlock holmes"), positions))
+	// Stop words still take up a position.
+	require.False(t, phraseAt(textTokens(t, "city sherlock holmes"), positions))
+	require.False(t, phraseAt(textTokens(t, "sherlock watson"), positions))
+}
+
+func TestWordsNear(t *testing.T) {
+	positions := textPositions(t, "From Baker Street to the city of Sherlock Holmes")
+	require.True(t, wordsNear(textTokens(t, "holmes sherlock"), positions, 1))
+	require.True(t, wordsNear(textTokens(t, "street city"), positions, 3))
+	require.False(t, wordsNear(textTokens(t, "street city"), positions, 2))
+	require.True(t, wordsNear(textTokens(t, "street holmes city"), positions, 6))
+	require.False(t, wordsNear(textTokens(t, "street holmes city"), positions, 5))
+	require.False(t, wordsNear(textTokens(t, "holmes watson"), positions, 10))
+}
@@ -173,7 +173,7 @@ func toKV(it *badger.Iterator, pk *x.ParsedKey) (*intern.KV, error) {
 	// Key would be modified by ReadPostingList as it advances the iterator and changes the item.
 	copy(key, item.Key())
 
-	if pk.IsSchema() {
+	if pk.IsSchema() || pk.IsTypeDef() {
 		val, err := item.ValueCopy(nil)
 		if err != nil {
 			return nil, err
@@ -267,8 +267,9 @@ func (w *grpcWorker) PredicateAndSchemaData(m *intern.SnapshotMeta, stream inter
 		copy(prevKey, k)
 
 		pk := x.Parse(prevKey)
-		// Schema keys always have version 1. So we send it irrespective of the timestamp.
-		if iterItem.Version() <= clientTs && !pk.IsSchema() {
+		// Schema and type keys always have version 1. So we send them irrespective of the
+		// timestamp.
+		if iterItem.Version() <= clientTs && !pk.IsSchema() && !pk.IsTypeDef() {
 			it.Next()
 			continue
 		}
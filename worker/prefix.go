@@ -0,0 +1,112 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"github.com/dgraph-io/badger"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// prefixTokenizer returns the tokenizer of the index whose keys prefix looks at. With the exact
+// index the values starting with the prefix are found, with the term index the values having a
+// word starting with it.
+func prefixTokenizer(attr string) (tok.Tokenizer, error) {
+	var found bool
+	if schema.State().IsIndexed(attr) {
+		for _, name := range schema.State().TokenizerNames(attr) {
+			if name == "exact" {
+				return tok.ExactTokenizer{}, nil
+			}
+			if name == "term" {
+				found = true
+			}
+		}
+	}
+	if !found {
+		return nil, x.Errorf("Attribute %s does not have exact or term index for prefix.", attr)
+	}
+	return tok.TermTokenizer{}, nil
+}
+
+// prefixToken returns the token which the index keys of the matches start with.
+func prefixToken(attr, prefix string) (string, error) {
+	tokenizer, err := prefixTokenizer(attr)
+	if err != nil {
+		return "", err
+	}
+	tokens, err := tok.BuildTokens(prefix, tokenizer)
+	if err != nil {
+		return "", err
+	}
+	if len(tokens) != 1 {
+		return "", x.Errorf("prefix on the term index of %s requires a single word, got: %q",
+			attr, prefix)
+	}
+	return tokens[0], nil
+}
+
+// handlePrefixFunction goes through the index keys starting with the prefix, in order. If only
+// the first few uids are wanted, it stops once it has found enough of them, so that with the
+// exact index they belong to the values coming first in order.
+func handlePrefixFunction(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	txn := pstore.NewTransactionAt(arg.q.ReadTs, false)
+	defer txn.Discard()
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	// TODO(txn): Like for inequalities, index keys written by pending transactions aren't seen.
+	prefixKey := x.IndexKey(attr, arg.srcFn.tokens[0])
+	it := posting.NewTxnPrefixIterator(txn, itOpt, prefixKey, prefixKey)
+	defer it.Close()
+
+	opts := posting.ListOptions{ReadTs: arg.q.ReadTs, Intersect: arg.q.UidList}
+	first := int(arg.q.First)
+	if needsStringFiltering(arg.srcFn, arg.q.Langs, attr) {
+		// Some of the uids found might not have a value in the language asked for.
+		first = 0
+	}
+	var lists []*intern.List
+	seen := make(map[uint64]struct{})
+	for ; it.Valid(); it.Next() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		key := make([]byte, len(it.Key()))
+		copy(key, it.Key())
+		arg.out.IndexKeys++
+		pl, err := posting.Get(key)
+		if err != nil {
+			return err
+		}
+		l, err := pl.Uids(opts)
+		if err != nil {
+			return err
+		}
+		lists = append(lists, l)
+		if first == 0 {
+			continue
+		}
+		for _, uid := range l.Uids {
+			seen[uid] = struct{}{}
+		}
+		if len(seen) >= first {
+			break
+		}
+	}
+	arg.out.UidMatrix = append(arg.out.UidMatrix, algo.MergeSorted(lists))
+	return nil
+}
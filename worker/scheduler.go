@@ -135,7 +135,7 @@ func (s *scheduler) schedule(proposal *intern.Proposal, index uint64) (err error
 	}
 
 	startTs := proposal.Mutations.StartTs
-	if len(proposal.Mutations.Schema) > 0 {
+	if len(proposal.Mutations.Schema) > 0 || len(proposal.Mutations.Types) > 0 {
 		if err = s.n.Applied.WaitForMark(s.n.ctx, index-1); err != nil {
 			posting.TxnMarks().Done(index)
 			return err
@@ -158,6 +158,13 @@ func (s *scheduler) schedule(proposal *intern.Proposal, index uint64) (err error
 				break
 			}
 		}
+		if err == nil {
+			for _, tupdate := range proposal.Mutations.Types {
+				if err = updateType(*tupdate); err != nil {
+					break
+				}
+			}
+		}
 		posting.TxnMarks().Done(index)
 		return
 	}
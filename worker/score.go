@@ -0,0 +1,152 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"encoding/binary"
+	"math"
+
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Parameters of the BM25 relevance score given to the matches of full text search. k1 limits
+// how much repeating a word adds to the score and b how much longer texts are penalised.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// bm25 returns the contribution of a word to the score of a text. The word occurs tf times in
+// the text, which is docLen words long, and in df of the numDocs texts indexed. Texts aren't
+// normalised by their length if the average length isn't known.
+func bm25(tf, df, numDocs, docLen int, avgDocLen float64) float64 {
+	idf := math.Log(1 + (float64(numDocs-df)+0.5)/(float64(df)+0.5))
+	norm := 1.0
+	if avgDocLen > 0 {
+		norm = 1 - bm25B + bm25B*float64(docLen)/avgDocLen
+	}
+	return idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + bm25K1*norm)
+}
+
+// docLengths returns the number of words of the values of attr indexed for the uids, along with
+// the number of values indexed and their average number of words. The lengths are looked up for
+// every uid, and the average is taken from the totals kept with them.
+func docLengths(attr string, readTs uint64, uids *intern.List) (map[uint64]int, int, float64,
+	error) {
+	pl, err := posting.Get(x.IndexKey(attr, tok.FullTextLengthsToken()))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	lengths := make(map[uint64]int, len(uids.Uids))
+	for _, uid := range uids.Uids {
+		opts := posting.ListOptions{ReadTs: readTs, AfterUID: uid - 1}
+		err := pl.Postings(opts, func(p *intern.Posting) bool {
+			if p.Uid != uid {
+				return false
+			}
+			if l, n := binary.Uvarint(p.Value); n > 0 {
+				lengths[uid] = int(l)
+			}
+			return false
+		})
+		if err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	words, numDocs, err := pl.FullTextStats(readTs)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if numDocs == 0 {
+		return lengths, 0, 0, nil
+	}
+	return lengths, numDocs, float64(words) / float64(numDocs), nil
+}
+
+// scoreFullTextMatches ranks the nodes matched by alloftext or anyoftext using BM25. The uids
+// matched are put in a single list and their scores in the value matrix, in the same order.
+func scoreFullTextMatches(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	var uids *intern.List
+	if arg.srcFn.intersectDest {
+		uids = algo.IntersectSorted(arg.out.UidMatrix)
+	} else {
+		uids = algo.MergeSorted(arg.out.UidMatrix)
+	}
+	lengths, numDocs, avgDocLen, err := docLengths(attr, arg.q.ReadTs, uids)
+	if err != nil {
+		return err
+	}
+
+	scores := make([]float64, len(uids.Uids))
+	opts := posting.ListOptions{ReadTs: arg.q.ReadTs}
+	for _, token := range arg.srcFn.tokens {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		pl, err := posting.Get(x.IndexKey(attr, token))
+		if err != nil {
+			return err
+		}
+		tfs := make(map[uint64]int)
+		var df int
+		err = pl.Postings(opts, func(p *intern.Posting) bool {
+			df++
+			if algo.IndexOf(uids, p.Uid) < 0 {
+				return true
+			}
+			// Values indexed without positions, like the ones of list and language tagged
+			// predicates, count as a single occurrence.
+			tfs[p.Uid] = 1
+			if positions, err := tok.DecodePositions(p.Positions); err == nil &&
+				len(positions) > 0 {
+				tfs[p.Uid] = len(positions)
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if df > numDocs {
+			numDocs = df
+		}
+		for i, uid := range uids.Uids {
+			tf, ok := tfs[uid]
+			if !ok {
+				continue
+			}
+			docLen, ok := lengths[uid]
+			if !ok {
+				docLen = int(avgDocLen)
+			}
+			scores[i] += bm25(tf, df, numDocs, docLen, avgDocLen)
+		}
+	}
+
+	arg.out.UidMatrix = []*intern.List{uids}
+	arg.out.ValueMatrix = arg.out.ValueMatrix[:0]
+	for _, score := range scores {
+		val := types.ValueForType(types.BinaryID)
+		if err := types.Marshal(types.Val{Tid: types.FloatID, Value: score}, &val); err != nil {
+			return err
+		}
+		arg.out.ValueMatrix = append(arg.out.ValueMatrix, &intern.ValueList{
+			Values: []*intern.TaskValue{{ValType: types.FloatID.Enum(), Val: val.Value.([]byte)}},
+		})
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestBM25(t *testing.T) {
+	base := bm25(1, 10, 100, 10, 10)
+	require.True(t, base > 0)
+	// Rarer words score higher.
+	require.True(t, bm25(1, 2, 100, 10, 10) > base)
+	// Repeated words score higher, but less than linearly.
+	require.True(t, bm25(2, 10, 100, 10, 10) > base)
+	require.True(t, bm25(2, 10, 100, 10, 10) < 2*base)
+	// Longer texts score lower.
+	require.True(t, bm25(1, 10, 100, 20, 10) < base)
+	// A word in every text still scores a little.
+	require.True(t, bm25(1, 100, 100, 10, 10) > 0)
+}
+
+func TestBM25NoLengths(t *testing.T) {
+	// Without an average length, texts of all lengths score the same.
+	require.Equal(t, bm25(1, 10, 100, 5, 0), bm25(1, 10, 100, 20, 0))
+	require.Equal(t, bm25(1, 10, 100, 10, 10), bm25(1, 10, 100, 5, 0))
+}
+
+func TestDocLengths(t *testing.T) {
+	initTest(t, `
+		score_text: string @index(fulltext) .
+		score_texts: [string] @index(fulltext) .
+	`)
+	for uid, text := range map[uint64]string{
+		1: "quick brown fox",
+		2: "lazy dog",
+		3: "the quick brown fox jumps over the lazy dog",
+	} {
+		edge := &intern.DirectedEdge{Entity: uid, Attr: "score_text", Value: []byte(text),
+			ValueType: intern.Posting_STRING}
+		addEdge(t, edge, getOrCreate(x.DataKey("score_text", uid)))
+	}
+	for _, text := range []string{"quick brown fox", "lazy dog"} {
+		edge := &intern.DirectedEdge{Entity: 1, Attr: "score_texts", Value: []byte(text),
+			ValueType: intern.Posting_STRING}
+		addEdge(t, edge, getOrCreate(x.DataKey("score_texts", 1)))
+	}
+	readTs := timestamp()
+
+	// Stop words aren't counted. The average is over all the values indexed, not just the
+	// ones of the uids.
+	lengths, numDocs, avgDocLen, err := docLengths("score_text", readTs,
+		&intern.List{Uids: []uint64{1, 3}})
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]int{1: 3, 3: 6}, lengths)
+	require.Equal(t, 3, numDocs)
+	require.Equal(t, 11.0/3, avgDocLen)
+
+	// Replacing a value replaces its length in the totals.
+	edge := &intern.DirectedEdge{Entity: 2, Attr: "score_text", Value: []byte("dog"),
+		ValueType: intern.Posting_STRING}
+	addEdge(t, edge, getOrCreate(x.DataKey("score_text", 2)))
+	lengths, numDocs, avgDocLen, err = docLengths("score_text", timestamp(),
+		&intern.List{Uids: []uint64{1}})
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]int{1: 3}, lengths)
+	require.Equal(t, 3, numDocs)
+	require.Equal(t, 10.0/3, avgDocLen)
+
+	// Deleting a value removes it.
+	edge = &intern.DirectedEdge{Entity: 3, Attr: "score_text", Value: []byte(x.Star),
+		ValueType: intern.Posting_STRING}
+	delEdge(t, edge, getOrCreate(x.DataKey("score_text", 3)))
+	_, numDocs, avgDocLen, err = docLengths("score_text", timestamp(),
+		&intern.List{Uids: []uint64{1}})
+	require.NoError(t, err)
+	require.Equal(t, 2, numDocs)
+	require.Equal(t, 2.0, avgDocLen)
+
+	// The values of list predicates share their postings, so their lengths aren't kept.
+	lengths, numDocs, avgDocLen, err = docLengths("score_texts", readTs,
+		&intern.List{Uids: []uint64{1}})
+	require.NoError(t, err)
+	require.Empty(t, lengths)
+	require.Equal(t, 0, numDocs)
+	require.Equal(t, 0.0, avgDocLen)
+}
@@ -106,6 +106,10 @@ func sortWithoutIndex(ctx context.Context, ts *intern.SortMessage) *sortresult {
 		return &sortresult{&emptySortResult, nil,
 			x.Errorf("Cannot sort attribute %s of type object.", ts.Order[0].Attr)}
 	}
+	after, err := cursorValues(ts)
+	if err != nil {
+		return &sortresult{&emptySortResult, nil, err}
+	}
 
 	for i := 0; i < n; i++ {
 		select {
@@ -118,7 +122,11 @@ func sortWithoutIndex(ctx context.Context, ts *intern.SortMessage) *sortresult {
 			if vals, err = sortByValue(ctx, ts, tempList, sType); err != nil {
 				return &sortresult{&emptySortResult, nil, err}
 			}
-			start, end, err := paginate(ts, tempList, vals)
+			var ties int
+			if after != nil {
+				vals, ties = skipToCursor(ts, after, tempList, vals)
+			}
+			start, end, err := paginate(ts, tempList, vals, ties)
 			if err != nil {
 				return &sortresult{&emptySortResult, nil, err}
 			}
@@ -194,6 +202,33 @@ func sortWithIndex(ctx context.Context, ts *intern.SortMessage) *sortresult {
 		// We need to reach the last key of this index type.
 		seekKey = x.IndexKey(order.Attr, string(tokenizer.Identifier()+1))
 	}
+	after, err := cursorValues(ts)
+	if err != nil {
+		return &sortresult{&emptySortResult, nil, err}
+	}
+	if after != nil {
+		if after[0].Value == nil {
+			if !order.Desc {
+				// Nodes without a value aren't in the index, and they come last.
+				for _, il := range out {
+					r.UidMatrix = append(r.UidMatrix, il.ulist)
+					values = append(values, il.values)
+				}
+				return &sortresult{r, values, nil}
+			}
+		} else {
+			// Start from the bucket of the cursor, skipping the ones before it.
+			v, err := types.Convert(after[0], typ)
+			if err != nil {
+				return &sortresult{&emptySortResult, nil, err}
+			}
+			tokens, err := tok.BuildTokens(v.Value, tokenizer)
+			if err != nil {
+				return &sortresult{&emptySortResult, nil, err}
+			}
+			seekKey = x.IndexKey(order.Attr, tokens[0])
+		}
+	}
 	it := posting.NewTxnPrefixIterator(txn, iterOpt, indexPrefix, seekKey)
 	defer it.Close()
 
@@ -219,7 +254,7 @@ BUCKETS:
 			}
 			// Intersect every UID list with the index bucket, and update their
 			// results (in out).
-			err := intersectBucket(ctx, ts, token, out)
+			err := intersectBucket(ctx, ts, after, token, out)
 			switch err {
 			case errDone:
 				break BUCKETS
@@ -255,6 +290,10 @@ type orderResult struct {
 }
 
 func multiSort(ctx context.Context, r *sortresult, ts *intern.SortMessage) error {
+	after, err := cursorValues(ts)
+	if err != nil {
+		return err
+	}
 	// SrcUids for other queries are all the uids present in the response of the first sort.
 	dest := destUids(r.reply.UidMatrix)
 
@@ -349,6 +388,9 @@ func multiSort(ctx context.Context, r *sortresult, ts *intern.SortMessage) error
 		if err := types.Sort(vals, ul, desc); err != nil {
 			return err
 		}
+		if after != nil {
+			ul.Uids = ul.Uids[cursorStart(ts, after, ul, vals, desc, nil):]
+		}
 		// Paginate
 		if len(ul.Uids) > int(ts.Count) {
 			ul.Uids = ul.Uids[:ts.Count]
@@ -366,7 +408,29 @@ func multiSort(ctx context.Context, r *sortresult, ts *intern.SortMessage) error
 // bucket if we haven't hit the offset. We stop getting results when we got
 // enough for our pagination params. When all the UID lists are done, we stop
 // iterating over the index.
+// processSort sorts the uid lists of ts within the deadline of the query, failing if the sorted
+// lists take more than what is left of its memory budget.
 func processSort(ctx context.Context, ts *intern.SortMessage) (*intern.SortResult, error) {
+	if ts.Deadline != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, time.Unix(0, ts.Deadline))
+		defer cancel()
+	}
+	r, err := sortUidMatrix(ctx, ts)
+	if err != nil {
+		return r, err
+	}
+	budget := &taskBudget{attr: ts.Order[0].Attr, limit: ts.MemoryBudget}
+	for _, l := range r.UidMatrix {
+		if err := budget.addUids(l); err != nil {
+			return nil, err
+		}
+	}
+	r.GroupId = groups().Node.RaftContext.Group
+	return r, nil
+}
+
+func sortUidMatrix(ctx context.Context, ts *intern.SortMessage) (*intern.SortResult, error) {
 	n := groups().Node
 	if err := n.WaitForMinProposal(ctx, ts.LinRead); err != nil {
 		return &emptySortResult, err
@@ -375,6 +439,17 @@ func processSort(ctx context.Context, ts *intern.SortMessage) (*intern.SortResul
 		return nil, x.Errorf("We do not yet support negative or infinite count with sorting: %s %d. "+
 			"Try flipping order and return first few elements instead.", ts.Order[0].Attr, ts.Count)
 	}
+	if _, err := cursorValues(ts); err != nil {
+		return nil, err
+	}
+	if needsFetchedValues(ts) {
+		r, err := sortByFetchedValues(ctx, ts)
+		if err != nil {
+			return nil, err
+		}
+		r.LinRead.Ids[n.RaftContext.Group] = n.Applied.DoneUntil()
+		return r, nil
+	}
 	if schema.State().IsList(ts.Order[0].Attr) {
 		return nil, x.Errorf("Sorting not supported on attr: %s of type: [scalar]", ts.Order[0].Attr)
 	}
@@ -428,6 +503,203 @@ func processSort(ctx context.Context, ts *intern.SortMessage) (*intern.SortResul
 	return r.reply, err
 }
 
+// needsFetchedValues returns whether any of the orders can't be served by the index: ordering
+// by a path of predicates or by an aggregate of the values, or placing the nodes without a
+// value explicitly.
+func needsFetchedValues(ts *intern.SortMessage) bool {
+	for _, o := range ts.Order {
+		if len(o.Path) > 0 || o.Aggregate != "" || o.Nulls != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// sortByFetchedValues sorts the uid lists by the values of every uid in them, fetched over
+// the network. Unlike the other sorts, it keeps the uids without a value.
+func sortByFetchedValues(ctx context.Context, ts *intern.SortMessage) (*intern.SortResult, error) {
+	after, err := cursorValues(ts)
+	if err != nil {
+		return nil, err
+	}
+	dest := destUids(ts.UidMatrix)
+	r := &intern.SortResult{LinRead: &api.LinRead{Ids: make(map[uint32]uint64)}}
+	// sortVals[i][j] is the value of the j-th uid of dest for the i-th order.
+	sortVals := make([][]types.Val, len(ts.Order))
+	desc := make([]bool, len(ts.Order))
+	nullsFirst := make([]bool, len(ts.Order))
+	for i, o := range ts.Order {
+		vals, err := orderValues(ctx, ts, o, dest, r.LinRead)
+		if err != nil {
+			return nil, err
+		}
+		sortVals[i] = vals
+		desc[i] = o.Desc
+		nullsFirst[i] = o.Nulls == "first" || (o.Nulls == "" && o.Desc)
+	}
+
+	for _, ul := range ts.UidMatrix {
+		// Copy, otherwise it'd affect the destUids and hence the srcUids of Next level.
+		list := &intern.List{Uids: append([]uint64{}, ul.Uids...)}
+		vals := make([][]types.Val, len(list.Uids))
+		for j, uid := range list.Uids {
+			idx := algo.IndexOf(dest, uid)
+			x.AssertTrue(idx >= 0)
+			vals[j] = make([]types.Val, len(ts.Order))
+			for i := range ts.Order {
+				vals[j][i] = sortVals[i][idx]
+			}
+		}
+		if err := types.SortWithNulls(vals, list, desc, nullsFirst); err != nil {
+			return nil, err
+		}
+		if after != nil {
+			list.Uids = list.Uids[cursorStart(ts, after, list, vals, desc, nullsFirst):]
+		}
+		start, end := x.PageRange(int(ts.Count), int(ts.Offset), len(list.Uids))
+		list.Uids = list.Uids[start:end]
+		r.UidMatrix = append(r.UidMatrix, list)
+	}
+	return r, nil
+}
+
+// orderValues returns the value each of the uids sorts by for the order: the first, min or
+// max of the values of the nodes reached from it through the path of the order.
+func orderValues(ctx context.Context, ts *intern.SortMessage, order *intern.Order,
+	uids *intern.List, lr *api.LinRead) ([]types.Val, error) {
+	// reached[i] are the nodes reached from the i-th uid so far.
+	reached := make([]*intern.List, len(uids.Uids))
+	for i, uid := range uids.Uids {
+		reached[i] = &intern.List{Uids: []uint64{uid}}
+	}
+	hops := append(append([]string{}, order.Path...), order.Attr)
+	vals := make([]types.Val, len(uids.Uids))
+	for h, attr := range hops {
+		frontier := destUids(reached)
+		if len(frontier.Uids) == 0 {
+			break
+		}
+		in := &intern.Query{
+			Attr:    attr,
+			UidList: frontier,
+			LinRead: ts.LinRead,
+			ReadTs:  ts.ReadTs,
+		}
+		if in.Reverse = strings.HasPrefix(attr, "~"); in.Reverse {
+			in.Attr = strings.TrimPrefix(attr, "~")
+		}
+		if h == len(hops)-1 {
+			in.Langs = order.Langs
+		}
+		result, err := ProcessTaskOverNetwork(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		y.MergeLinReads(lr, result.LinRead)
+
+		for i, nodes := range reached {
+			next := &intern.List{}
+			for _, uid := range nodes.Uids {
+				idx := algo.IndexOf(frontier, uid)
+				if h < len(hops)-1 {
+					next.Uids = append(next.Uids, result.UidMatrix[idx].Uids...)
+					continue
+				}
+				for _, tv := range result.ValueMatrix[idx].Values {
+					v := types.ValueForType(types.TypeID(tv.ValType))
+					v.Value = tv.Val
+					if v, err = types.Convert(v, v.Tid); err != nil {
+						continue
+					}
+					vals[i] = pickOrderValue(order.Aggregate, vals[i], v)
+				}
+			}
+			reached[i] = next
+		}
+	}
+	return vals, nil
+}
+
+// pickOrderValue returns which of the value picked so far and the next one the aggregate of
+// an order picks.
+func pickOrderValue(aggregate string, picked, next types.Val) types.Val {
+	if picked.Value == nil {
+		return next
+	}
+	switch aggregate {
+	case "min":
+		if l, err := types.Less(next, picked); err == nil && l {
+			return next
+		}
+	case "max":
+		if l, err := types.Less(picked, next); err == nil && l {
+			return next
+		}
+	}
+	return picked
+}
+
+// cursorValues returns the values of the cursor the elements are asked after, one for each
+// order, or nil if there's none.
+func cursorValues(ts *intern.SortMessage) ([]types.Val, error) {
+	if ts.After == nil {
+		return nil, nil
+	}
+	if len(ts.After.Values) != len(ts.Order) {
+		return nil, x.Errorf("Cursor in after doesn't match the order of the block")
+	}
+	vals := make([]types.Val, len(ts.After.Values))
+	for i, tv := range ts.After.Values {
+		if ts.After.Nulls&(1<<uint(i)) != 0 {
+			continue
+		}
+		v := types.ValueForType(types.TypeID(tv.ValType))
+		v.Value = tv.Val
+		var err error
+		if vals[i], err = types.Convert(v, v.Tid); err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+// cursorStart returns the position of the first uid of ul after the cursor, given the values
+// of the uids it's sorted by.
+func cursorStart(ts *intern.SortMessage, after []types.Val, ul *intern.List,
+	vals [][]types.Val, desc, nullsFirst []bool) int {
+	for i, uid := range ul.Uids {
+		c := types.CompareSortValues(vals[i], after, desc, nullsFirst)
+		if c > 0 || (c == 0 && uid > ts.After.Uid) {
+			return i
+		}
+	}
+	return len(ul.Uids)
+}
+
+// skipToCursor removes the uids of ul up to the cursor, given their values vals for the first
+// order they're sorted by. With more orders, the uids with the same value as the cursor are kept
+// to be sorted by the rest, and their number is returned.
+func skipToCursor(ts *intern.SortMessage, after []types.Val, ul *intern.List,
+	vals []types.Val) ([]types.Val, int) {
+	desc := []bool{ts.Order[0].Desc}
+	compare := func(i int) int {
+		return types.CompareSortValues(vals[i:i+1], after[:1], desc, nil)
+	}
+	start := 0
+	for ; start < len(ul.Uids); start++ {
+		c := compare(start)
+		if c > 0 || (c == 0 && (len(ts.Order) > 1 || ul.Uids[start] > ts.After.Uid)) {
+			break
+		}
+	}
+	ties := 0
+	for len(ts.Order) > 1 && start+ties < len(ul.Uids) && compare(start+ties) == 0 {
+		ties++
+	}
+	ul.Uids = ul.Uids[start:]
+	return vals[start:], ties
+}
+
 func destUids(uidMatrix []*intern.List) *intern.List {
 	included := make(map[uint64]struct{})
 	for _, ul := range uidMatrix {
@@ -463,12 +735,15 @@ type intersectedList struct {
 	ulist  *intern.List
 	values []types.Val
 	uset   map[uint64]struct{}
+	// Number of uids with the same value as the cursor for the first order, which might be
+	// before it by the other orders.
+	ties int
 }
 
 // intersectBucket intersects every UID list in the UID matrix with the
 // indexed bucket.
-func intersectBucket(ctx context.Context, ts *intern.SortMessage, token string,
-	out []intersectedList) error {
+func intersectBucket(ctx context.Context, ts *intern.SortMessage, after []types.Val,
+	token string, out []intersectedList) error {
 	count := int(ts.Count)
 	order := ts.Order[0]
 	sType, err := schema.State().TypeOf(order.Attr)
@@ -485,7 +760,7 @@ func intersectBucket(ctx context.Context, ts *intern.SortMessage, token string,
 	// For each UID list, we need to intersect with the index bucket.
 	for i, ul := range ts.UidMatrix {
 		il := &out[i]
-		if count > 0 && len(il.ulist.Uids) >= count {
+		if count > 0 && len(il.ulist.Uids) >= count+il.ties {
 			continue
 		}
 
@@ -517,6 +792,11 @@ func intersectBucket(ctx context.Context, ts *intern.SortMessage, token string,
 		if vals, err = sortByValue(ctx, ts, result, scalar); err != nil {
 			return err
 		}
+		if after != nil {
+			var ties int
+			vals, ties = skipToCursor(ts, after, result, vals)
+			il.ties += ties
+		}
 
 		// Result set might have reduced after sorting. As some uids might not have a
 		// value in the lang specified.
@@ -550,7 +830,7 @@ func intersectBucket(ctx context.Context, ts *intern.SortMessage, token string,
 
 	// Check out[i] sizes for all i.
 	for i := 0; i < len(ts.UidMatrix); i++ { // Iterate over UID lists.
-		if len(out[i].ulist.Uids) < count {
+		if len(out[i].ulist.Uids) < count+out[i].ties {
 			return errContinue
 		}
 
@@ -579,8 +859,14 @@ func removeDuplicates(uids []uint64, set map[uint64]struct{}) []uint64 {
 	return uids
 }
 
-func paginate(ts *intern.SortMessage, dest *intern.List, vals []types.Val) (int, int, error) {
+// paginate returns the range of dest in the page. The first ties uids, which might be before the
+// cursor by the other orders, aren't counted in it.
+func paginate(ts *intern.SortMessage, dest *intern.List, vals []types.Val,
+	ties int) (int, int, error) {
 	count := int(ts.Count)
+	if count > 0 {
+		count += ties
+	}
 	offset := int(ts.Offset)
 	start, end := x.PageRange(count, offset, len(dest.Uids))
 
@@ -626,7 +912,7 @@ func sortByValue(ctx context.Context, ts *intern.SortMessage, ul *intern.List,
 	}
 	err := types.Sort(values, &intern.List{uids}, []bool{order.Desc})
 	ul.Uids = uids
-	if len(ts.Order) > 1 {
+	if len(ts.Order) > 1 || ts.After != nil {
 		for _, v := range values {
 			multiSortVals = append(multiSortVals, v[0])
 		}
@@ -11,6 +11,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/types"
 )
 
 func TestRemoveDuplicates(t *testing.T) {
@@ -45,3 +48,61 @@ func TestRemoveDuplicates(t *testing.T) {
 		require.Equal(t, set, toSet(test.setOut))
 	}
 }
+
+func TestPickOrderValue(t *testing.T) {
+	vals := []types.Val{
+		{Tid: types.IntID, Value: int64(20)},
+		{Tid: types.IntID, Value: int64(10)},
+		{Tid: types.IntID, Value: int64(30)},
+	}
+	for aggregate, want := range map[string]int64{"": 20, "first": 20, "min": 10, "max": 30} {
+		var picked types.Val
+		for _, v := range vals {
+			picked = pickOrderValue(aggregate, picked, v)
+		}
+		require.Equal(t, want, picked.Value, aggregate)
+	}
+}
+
+func TestSkipToCursor(t *testing.T) {
+	str := func(s string) types.Val { return types.Val{Tid: types.StringID, Value: s} }
+	ts := &intern.SortMessage{
+		Order: []*intern.Order{{Attr: "name"}},
+		After: &intern.SortCursor{
+			Values: []*intern.TaskValue{{Val: []byte("b"), ValType: intern.Posting_STRING}},
+			Uid:    2,
+		},
+	}
+	after, err := cursorValues(ts)
+	require.NoError(t, err)
+	ul := &intern.List{Uids: []uint64{5, 1, 2, 3, 4}}
+	vals, ties := skipToCursor(ts, after, ul,
+		[]types.Val{str("a"), str("b"), str("b"), str("b"), str("c")})
+	require.Equal(t, []uint64{3, 4}, ul.Uids)
+	require.Equal(t, []types.Val{str("b"), str("c")}, vals)
+	require.Equal(t, 0, ties)
+
+	// With more orders, the uids with the value of the cursor for the first one are kept.
+	ts.Order = append(ts.Order, &intern.Order{Attr: "age", Desc: true})
+	ts.After.Values = append(ts.After.Values, &intern.TaskValue{})
+	ts.After.Nulls = 2
+	after, err = cursorValues(ts)
+	require.NoError(t, err)
+	require.Nil(t, after[1].Value)
+	ul = &intern.List{Uids: []uint64{5, 1, 2, 3, 4}}
+	_, ties = skipToCursor(ts, after, ul,
+		[]types.Val{str("a"), str("b"), str("b"), str("b"), str("c")})
+	require.Equal(t, []uint64{1, 2, 3, 4}, ul.Uids)
+	require.Equal(t, 3, ties)
+
+	// The nodes without an age come first in descending order.
+	age := types.Val{Tid: types.IntID, Value: int64(30)}
+	ul = &intern.List{Uids: []uint64{2, 3, 1, 4}}
+	start := cursorStart(ts, after, ul, [][]types.Val{
+		{str("b"), {}}, {str("b"), {}}, {str("b"), age}, {str("c"), {}}}, []bool{false, true}, nil)
+	require.Equal(t, 1, start)
+
+	ts.After.Values = ts.After.Values[:1]
+	_, err = cursorValues(ts)
+	require.Error(t, err)
+}
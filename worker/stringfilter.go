@@ -19,6 +19,7 @@ import (
 type matchFn func(types.Val, stringFilter) bool
 
 type stringFilter struct {
+	attr      string
 	funcName  string
 	funcType  FuncType
 	lang      string
@@ -69,6 +70,15 @@ func defaultMatch(value types.Val, filter stringFilter) bool {
 	}
 }
 
+func prefixMatch(value types.Val, filter stringFilter) bool {
+	for _, token := range tokenizeValue(value, filter) {
+		if strings.HasPrefix(token, filter.tokens[0]) {
+			return true
+		}
+	}
+	return false
+}
+
 func ineqMatch(value types.Val, filter stringFilter) bool {
 	if len(filter.eqVals) == 0 {
 		return types.CompareVals(filter.funcName, value, filter.ineqValue)
@@ -83,18 +93,19 @@ func ineqMatch(value types.Val, filter stringFilter) bool {
 }
 
 func tokenizeValue(value types.Val, filter stringFilter) []string {
-	var tokName string
+	var tokenizer tok.Tokenizer
+	var err error
 	switch filter.funcType {
 	case StandardFn:
-		tokName = "term"
+		tokenizer, _ = tok.GetTokenizer("term")
 	case FullTextSearchFn:
-		tokName = tok.FtsTokenizerName(filter.lang)
+		tokenizer, err = FullTextTokenizer(filter.attr, filter.lang)
+	case PrefixFn:
+		tokenizer, err = prefixTokenizer(filter.attr)
 	}
 
-	tokenizer, found := tok.GetTokenizer(tokName)
-
 	// tokenizer was used in previous stages of query proccessing, it has to be available
-	x.AssertTrue(found)
+	x.AssertTrue(err == nil && tokenizer != nil)
 	tokens, err := tok.BuildTokens(value.Value, tokenizer)
 	if err == nil {
 		return tokens
@@ -208,10 +208,15 @@ const (
 	GeoFn
 	PasswordFn
 	RegexFn
+	MatchFn
+	SimilarToFn
 	FullTextSearchFn
+	PhraseFn
+	PrefixFn
 	HasFn
 	UidInFn
 	CustomIndexFn
+	TermsFn
 	StandardFn = 100
 )
 
@@ -238,20 +243,31 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 	switch f {
 	case "le", "ge", "lt", "gt", "eq":
 		return CompareAttrFn, f
-	case "min", "max", "sum", "avg":
+	case "min", "max", "sum", "avg", "count_distinct", "median", "percentile", "stddev",
+		"variance":
 		return AggregatorFn, f
 	case "checkpwd":
 		return PasswordFn, f
 	case "regexp":
 		return RegexFn, f
+	case "match":
+		return MatchFn, f
+	case "similar_to":
+		return SimilarToFn, f
 	case "alloftext", "anyoftext":
 		return FullTextSearchFn, f
+	case "phrase", "near_words":
+		return PhraseFn, f
+	case "prefix":
+		return PrefixFn, f
 	case "has":
 		return HasFn, f
 	case "uid_in":
 		return UidInFn, f
 	case "anyof", "allof":
 		return CustomIndexFn, f
+	case "terms":
+		return TermsFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return GeoFn, f
@@ -262,7 +278,8 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 
 func needsIndex(fnType FuncType) bool {
 	switch fnType {
-	case CompareAttrFn, GeoFn, RegexFn, FullTextSearchFn, StandardFn:
+	case CompareAttrFn, GeoFn, RegexFn, MatchFn, SimilarToFn, FullTextSearchFn, PhraseFn,
+		PrefixFn, TermsFn, StandardFn:
 		return true
 	default:
 		return false
@@ -291,7 +308,8 @@ func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error)
 			return false, nil
 		}
 		return true, nil
-	case GeoFn, RegexFn, FullTextSearchFn, StandardFn, HasFn, CustomIndexFn:
+	case GeoFn, RegexFn, MatchFn, SimilarToFn, FullTextSearchFn, PhraseFn, PrefixFn, StandardFn,
+		HasFn, CustomIndexFn, TermsFn:
 		// All of these require index, hence would require fetching uid postings.
 		return false, nil
 	case UidInFn, CompareScalarFn:
@@ -333,6 +351,7 @@ func handleValuePostings(ctx context.Context, args funcArgs) error {
 
 	var key []byte
 	listType := schema.State().IsList(attr)
+	budget := newTaskBudget(q)
 	for i := 0; i < srcFn.n; i++ {
 		select {
 		case <-ctx.Done():
@@ -408,6 +427,9 @@ func handleValuePostings(ctx context.Context, args funcArgs) error {
 			}
 		}
 		out.ValueMatrix = append(out.ValueMatrix, &vl)
+		if err := budget.addValues(&vl); err != nil {
+			return err
+		}
 
 		if q.FacetsFilter != nil { // else part means isValueEdge
 			// This is Value edge and we are asked to do facet filtering. Not supported.
@@ -456,6 +478,9 @@ func handleValuePostings(ctx context.Context, args funcArgs) error {
 			out.UidMatrix = append(out.UidMatrix, &emptyUIDList)
 		default:
 			out.UidMatrix = append(out.UidMatrix, uidList)
+			if err := budget.addUids(uidList); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -474,6 +499,7 @@ func handleUidPostings(ctx context.Context, args funcArgs, opts posting.ListOpti
 		return err
 	}
 
+	budget := newTaskBudget(q)
 	for i := 0; i < srcFn.n; i++ {
 		select {
 		case <-ctx.Done():
@@ -490,8 +516,10 @@ func handleUidPostings(ctx context.Context, args funcArgs, opts posting.ListOpti
 			}
 		case GeoFn, RegexFn, FullTextSearchFn, StandardFn, CustomIndexFn:
 			key = x.IndexKey(attr, srcFn.tokens[i])
+			out.IndexKeys++
 		case CompareAttrFn:
 			key = x.IndexKey(attr, srcFn.tokens[i])
+			out.IndexKeys++
 		default:
 			return x.Errorf("Unhandled function in handleUidPostings: %s", srcFn.fname)
 		}
@@ -586,6 +614,9 @@ func handleUidPostings(ctx context.Context, args funcArgs, opts posting.ListOpti
 				uidList.Uids = append(uidList.Uids, fres.uid)
 			}
 			out.UidMatrix = append(out.UidMatrix, uidList)
+			if err := budget.addUids(uidList); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -593,6 +624,11 @@ func handleUidPostings(ctx context.Context, args funcArgs, opts posting.ListOpti
 
 // processTask processes the query, accumulates and returns the result.
 func processTask(ctx context.Context, q *intern.Query, gid uint32) (*intern.Result, error) {
+	if q.Deadline != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, time.Unix(0, q.Deadline))
+		defer cancel()
+	}
 	n := groups().Node
 	if err := posting.Oracle().WaitForTs(ctx, q.ReadTs); err != nil {
 		return &emptyResult, err
@@ -617,6 +653,7 @@ func processTask(ctx context.Context, q *intern.Query, gid uint32) (*intern.Resu
 	if err != nil {
 		return &emptyResult, err
 	}
+	out.GroupId = gid
 	out.LinRead = &api.LinRead{Ids: make(map[uint32]uint64)}
 	out.LinRead.Ids[n.RaftContext.Group] = n.Applied.DoneUntil()
 	return out, nil
@@ -699,6 +736,42 @@ func helpProcessTask(ctx context.Context, q *intern.Query, gid uint32) (*intern.
 		}
 	}
 
+	if srcFn.fnType == MatchFn {
+		// Get the candidates from the trigram index and filter them by their
+		// edit distance to the given text.
+		if err := handleMatchFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
+	if srcFn.fnType == SimilarToFn {
+		if err := handleSimilarToFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
+	if srcFn.fnType == PhraseFn {
+		// Get the nodes having all the words from the full text index and check the
+		// positions of the words.
+		if err := handlePhraseFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
+	if srcFn.fnType == PrefixFn {
+		// Go through the index keys starting with the prefix.
+		if err := handlePrefixFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
+	if srcFn.fnType == TermsFn {
+		// Count the nodes of every index key.
+		if err := handleTermsFunction(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
 	// We fetch the actual value for the uids, compare them to the value in the
 	// request and filter the uids only if the tokenizer IsLossy.
 	if srcFn.fnType == CompareAttrFn && len(srcFn.tokens) > 0 {
@@ -717,6 +790,21 @@ func helpProcessTask(ctx context.Context, q *intern.Query, gid uint32) (*intern.
 		filterStringFunction(funcArgs{q, gid, srcFn, out})
 	}
 
+	// Full text search at root also ranks the matches, if their scores are wanted.
+	if srcFn.fnType == FullTextSearchFn && q.UidList == nil && q.FuncVals {
+		if err := scoreFullTextMatches(ctx, funcArgs{q, gid, srcFn, out}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Sampling here saves sending back the uids left out of the sample.
+	if q.Random > 0 {
+		r := rand.New(rand.NewSource(q.Seed))
+		for _, l := range out.UidMatrix {
+			algo.Sample(l, int(q.Random), r)
+		}
+	}
+
 	out.IntersectDest = srcFn.intersectDest
 	return out, nil
 }
@@ -734,7 +822,8 @@ func needsStringFiltering(srcFn *functionContext, langs []string, attr string) b
 
 	return langForFunc(langs) != "." &&
 		(srcFn.fnType == StandardFn || srcFn.fnType == HasFn ||
-			srcFn.fnType == FullTextSearchFn || srcFn.fnType == CompareAttrFn)
+			srcFn.fnType == FullTextSearchFn || srcFn.fnType == CompareAttrFn ||
+			srcFn.fnType == PrefixFn)
 }
 
 func handleCompareScalarFunction(arg funcArgs) error {
@@ -1041,6 +1130,7 @@ func filterStringFunction(arg funcArgs) error {
 
 	filtered := &intern.List{Uids: filteredUids}
 	filter := stringFilter{
+		attr:     attr,
 		funcName: arg.srcFn.fname,
 		funcType: arg.srcFn.fnType,
 		lang:     lang,
@@ -1059,6 +1149,10 @@ func filterStringFunction(arg funcArgs) error {
 		filter.eqVals = arg.srcFn.eqTokens
 		filter.match = ineqMatch
 		filtered = matchStrings(filtered, values, filter)
+	case PrefixFn:
+		filter.tokens = arg.srcFn.tokens
+		filter.match = prefixMatch
+		filtered = matchStrings(filtered, values, filter)
 	}
 
 	for i := 0; i < len(arg.out.UidMatrix); i++ {
@@ -1084,6 +1178,12 @@ type functionContext struct {
 	fname          string
 	fnType         FuncType
 	regex          *cregexp.Regexp
+	matchText      string
+	maxDistance    int
+	vector         []float32
+	numNeighbours  int
+	textTokens     []tok.TextToken
+	nearDistance   int
 	isFuncAtRoot   bool
 	isStringFn     bool
 	atype          types.TypeID
@@ -1221,7 +1321,8 @@ func parseSrcFn(q *intern.Query) (*functionContext, error) {
 		if !found {
 			return nil, x.Errorf("Attribute %s is not indexed with type %s", attr, required)
 		}
-		if fc.tokens, err = getStringTokens(q.SrcFunc.Args, langForFunc(q.Langs), fnType); err != nil {
+		if fc.tokens, err = getStringTokens(attr, q.SrcFunc.Args, langForFunc(q.Langs),
+			fnType); err != nil {
 			return nil, err
 		}
 		fnName := strings.ToLower(q.SrcFunc.Name)
@@ -1270,6 +1371,84 @@ func parseSrcFn(q *intern.Query) (*functionContext, error) {
 			return nil, err
 		}
 		fc.n = 0
+	case TermsFn:
+		// The order and its direction.
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		fc.n = 0
+	case PrefixFn:
+		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
+			return nil, err
+		}
+		var token string
+		if token, err = prefixToken(attr, q.SrcFunc.Args[0]); err != nil {
+			return nil, err
+		}
+		fc.tokens = []string{token}
+		fc.n = 0
+	case MatchFn:
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		fc.matchText = q.SrcFunc.Args[0]
+		if fc.maxDistance, err = strconv.Atoi(q.SrcFunc.Args[1]); err != nil {
+			return nil, x.Wrapf(err, "match requires the max distance to be an integer,"+
+				" but got: %v", q.SrcFunc.Args[1])
+		}
+		if fc.maxDistance < 0 {
+			return nil, x.Errorf("match requires a non-negative max distance, but got: %d",
+				fc.maxDistance)
+		}
+		fc.n = 0
+	case SimilarToFn:
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		if fc.numNeighbours, err = strconv.Atoi(q.SrcFunc.Args[0]); err != nil {
+			return nil, x.Wrapf(err, "similar_to requires the number of neighbours to be an"+
+				" integer, but got: %v", q.SrcFunc.Args[0])
+		}
+		if fc.numNeighbours <= 0 {
+			return nil, x.Errorf("similar_to requires a positive number of neighbours, but got: %d",
+				fc.numNeighbours)
+		}
+		if fc.vector, err = types.ParseVFloat(q.SrcFunc.Args[1]); err != nil {
+			return nil, err
+		}
+		fc.n = 0
+	case PhraseFn:
+		if fc.fname == "near_words" {
+			if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+				return nil, err
+			}
+			if fc.nearDistance, err = strconv.Atoi(q.SrcFunc.Args[1]); err != nil {
+				return nil, x.Wrapf(err, "near_words requires the distance to be an integer,"+
+					" but got: %v", q.SrcFunc.Args[1])
+			}
+			if fc.nearDistance < 0 {
+				return nil, x.Errorf("near_words requires a non-negative distance, but got: %d",
+					fc.nearDistance)
+			}
+		} else if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
+			return nil, err
+		}
+		required, found := verifyStringIndex(attr, FullTextSearchFn)
+		if !found {
+			return nil, x.Errorf("Attribute %s is not indexed with type %s", attr, required)
+		}
+		tokenizer, err := FullTextTokenizer(attr, langForFunc(q.Langs))
+		if err != nil {
+			return nil, err
+		}
+		if fc.textTokens, err = tok.TokenPositions(tokenizer, q.SrcFunc.Args[0]); err != nil {
+			return nil, err
+		}
+		if len(fc.textTokens) == 0 {
+			return nil, x.Errorf("%s requires some words besides stop words, but got: %q",
+				fc.fname, q.SrcFunc.Args[0])
+		}
+		fc.n = 0
 	case HasFn:
 		if err = ensureArgsCount(q.SrcFunc, 0); err != nil {
 			return nil, err
@@ -1608,6 +1787,7 @@ func (cp *countParams) evaluate(out *intern.Result) error {
 // This is not transactionally isolated, add to docs
 func handleHasFunction(ctx context.Context, q *intern.Query, out *intern.Result) error {
 	tlist := &intern.List{}
+	budget := newTaskBudget(q)
 
 	txn := pstore.NewTransactionAt(q.ReadTs, false)
 	defer txn.Discard()
@@ -1654,6 +1834,9 @@ func handleHasFunction(ctx context.Context, q *intern.Query, out *intern.Result)
 		}
 		w++
 		tlist.Uids = append(tlist.Uids, pk.Uid)
+		if err := budget.add(8); err != nil {
+			return err
+		}
 	}
 
 	out.UidMatrix = append(out.UidMatrix, tlist)
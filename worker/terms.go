@@ -0,0 +1,174 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"sort"
+
+	"github.com/dgraph-io/badger"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// termsTokenizer returns the tokenizer of the index whose keys terms goes through. The exact
+// index has the values in its keys and the term index the words, while the hash index only has
+// hashes of the values.
+func termsTokenizer(attr string) (tok.Tokenizer, error) {
+	var found tok.Tokenizer
+	if schema.State().IsIndexed(attr) {
+		for _, name := range schema.State().TokenizerNames(attr) {
+			switch name {
+			case "exact":
+				return tok.ExactTokenizer{}, nil
+			case "hash":
+				found = tok.HashTokenizer{}
+			case "term":
+				if found == nil {
+					found = tok.TermTokenizer{}
+				}
+			}
+		}
+	}
+	if found == nil {
+		return nil, x.Errorf("Attribute %s does not have exact, hash or term index for terms.",
+			attr)
+	}
+	return found, nil
+}
+
+type termCount struct {
+	term  string
+	count int
+}
+
+// handleTermsFunction counts the nodes having each of the indexed values of the predicate, from
+// the lengths of the posting lists of its index keys, intersected with the uid list if there's
+// one. The values are returned in the value matrix, ordered as asked for, and their counts in
+// counts.
+func handleTermsFunction(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	tokenizer, err := termsTokenizer(attr)
+	if err != nil {
+		return err
+	}
+	txn := pstore.NewTransactionAt(arg.q.ReadTs, false)
+	defer txn.Discard()
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	// TODO(txn): Like for inequalities, index keys written by pending transactions aren't seen.
+	prefixKey := x.IndexKey(attr, string(tokenizer.Identifier()))
+	it := posting.NewTxnPrefixIterator(txn, itOpt, prefixKey, prefixKey)
+	defer it.Close()
+
+	opts := posting.ListOptions{ReadTs: arg.q.ReadTs, Intersect: arg.q.UidList}
+	var terms []termCount
+	for ; it.Valid(); it.Next() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		key := make([]byte, len(it.Key()))
+		copy(key, it.Key())
+		arg.out.IndexKeys++
+		pl, err := posting.Get(key)
+		if err != nil {
+			return err
+		}
+		var count int
+		var firstUid uint64
+		if arg.q.UidList == nil {
+			if count = pl.Length(arg.q.ReadTs, 0); count == -1 {
+				return posting.ErrTsTooOld
+			}
+		} else {
+			l, err := pl.Uids(opts)
+			if err != nil {
+				return err
+			}
+			if count = len(l.Uids); count > 0 {
+				firstUid = l.Uids[0]
+			}
+		}
+		if count == 0 {
+			continue
+		}
+		token := x.Parse(key).Term
+		term := token[1:]
+		if _, ok := tokenizer.(tok.HashTokenizer); ok {
+			if arg.q.UidList == nil {
+				err = pl.Iterate(arg.q.ReadTs, 0, func(p *intern.Posting) bool {
+					firstUid = p.Uid
+					return false
+				})
+				if err != nil {
+					return err
+				}
+			}
+			if term, err = hashedValue(arg.q.ReadTs, attr, token, firstUid); err != nil {
+				return err
+			}
+		}
+		terms = append(terms, termCount{term: term, count: count})
+	}
+
+	args := arg.q.SrcFunc.Args
+	byCount, desc := args[0] == "count", args[1] == "desc"
+	// Terms with the same count are in ascending order.
+	sort.Slice(terms, func(i, j int) bool {
+		a, b := terms[i], terms[j]
+		if byCount && a.count != b.count {
+			return (a.count > b.count) == desc
+		}
+		if !byCount && desc {
+			return a.term > b.term
+		}
+		return a.term < b.term
+	})
+	if first := int(arg.q.First); first > 0 && first < len(terms) {
+		terms = terms[:first]
+	}
+
+	values := &intern.ValueList{}
+	for _, t := range terms {
+		values.Values = append(values.Values,
+			&intern.TaskValue{ValType: types.StringID.Enum(), Val: []byte(t.term)})
+		arg.out.Counts = append(arg.out.Counts, uint32(t.count))
+	}
+	arg.out.ValueMatrix = append(arg.out.ValueMatrix, values)
+	return nil
+}
+
+// hashedValue returns the value of the node whose hash is the token of the hash index.
+func hashedValue(readTs uint64, attr, token string, uid uint64) (string, error) {
+	pl, err := posting.Get(x.DataKey(attr, uid))
+	if err != nil {
+		return "", err
+	}
+	vals, err := pl.AllValues(readTs)
+	if err != nil {
+		return "", err
+	}
+	for _, val := range vals {
+		sv, err := types.Convert(val, types.StringID)
+		if err != nil {
+			continue
+		}
+		tokens, err := tok.BuildTokens(sv.Value, tok.HashTokenizer{})
+		if err == nil && len(tokens) == 1 && tokens[0] == token {
+			return sv.Value.(string), nil
+		}
+	}
+	return "", x.Errorf("Value of index key not found for attribute %s", attr)
+}
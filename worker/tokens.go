@@ -56,15 +56,37 @@ func verifyCustomIndex(attr string, tokenizerName string) bool {
 	return false
 }
 
-// Return string tokens from function arguments. It maps function type to correct tokenizer.
-// Note: regexp functions require regexp compilation of argument, not tokenization.
-func getStringTokens(funcArgs []string, lang string, funcType FuncType) ([]string, error) {
+// FullTextTokenizer returns the tokenizer of the full text index of attr for the given language.
+// If the schema configures the analyzer of attr, it's used whatever the language.
+func FullTextTokenizer(attr, lang string) (tok.Tokenizer, error) {
+	if t, ok := schema.State().FullTextAnalyzer(attr); ok {
+		return t, nil
+	}
 	if lang == "." {
 		lang = "en"
 	}
+	name := tok.FtsTokenizerName(lang)
+	t, found := tok.GetTokenizer(name)
+	if !found {
+		return nil, x.Errorf("Tokenizer not found for %s", name)
+	}
+	return t, nil
+}
+
+// Return string tokens from function arguments. It maps function type to correct tokenizer.
+// Note: regexp functions require regexp compilation of argument, not tokenization.
+func getStringTokens(attr string, funcArgs []string, lang string,
+	funcType FuncType) ([]string, error) {
 	switch funcType {
 	case FullTextSearchFn:
-		return tok.GetTextTokens(funcArgs, lang)
+		if len(funcArgs) != 1 {
+			return nil, x.Errorf("Function requires 1 arguments, but got %d", len(funcArgs))
+		}
+		t, err := FullTextTokenizer(attr, lang)
+		if err != nil {
+			return nil, err
+		}
+		return tok.BuildTokens(funcArgs[0], t)
 	default:
 		return tok.GetTokens(funcArgs)
 	}
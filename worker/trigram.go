@@ -35,6 +35,7 @@ func uidsForRegex(attr string, arg funcArgs,
 
 	uidsForTrigram := func(trigram string) (*intern.List, error) {
 		key := x.IndexKey(attr, trigram)
+		arg.out.IndexKeys++
 		pl, err := posting.Get(key)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,207 @@
+/*
+ * Copyright 2018 Dgraph Labs, Inc.
+ *
+ * This file is available under the Apache License, Version 2.0,
+ * with the Commons Clause restriction.
+ */
+
+package worker
+
+import (
+	"sort"
+
+	"github.com/dgraph-io/badger"
+	"golang.org/x/net/context"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/intern"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// We look at more buckets of the vector index until we have this many candidates per
+// requested neighbour, to make up for the neighbours hashed into other buckets.
+const candidatesPerNeighbour = 4
+
+// maxVectorScan is the largest number of values of a predicate for which similar_to computes
+// the distance to every one of them, when its buckets of the vector index hold fewer uids than
+// the neighbours requested. Predicates with more values return the neighbours found in the
+// buckets, even if there are fewer than requested, rather than turning into a brute-force scan.
+const maxVectorScan = 10000
+
+type neighbour struct {
+	uid  uint64
+	dist float64
+}
+
+// uidsForSimilarTo returns the uids in the buckets of the vector index close to the bucket of
+// the vector of the function. If those don't hold as many uids as the neighbours requested, all
+// the uids having the predicate are returned, as long as there are at most maxVectorScan.
+func uidsForSimilarTo(ctx context.Context, attr string, arg funcArgs) (*intern.List, error) {
+	want := candidatesPerNeighbour * arg.srcFn.numNeighbours
+	opts := posting.ListOptions{ReadTs: arg.q.ReadTs}
+	var uids *intern.List
+	numTokens := 0
+	for flips := 0; ; flips += 4 {
+		tokens, err := tok.VectorProbeTokens(arg.srcFn.vector, flips)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) == numTokens {
+			// No more buckets to look at.
+			break
+		}
+		numTokens = len(tokens)
+
+		lists := make([]*intern.List, 0, len(tokens))
+		for _, t := range tokens {
+			arg.out.IndexKeys++
+			pl, err := posting.Get(x.IndexKey(attr, t))
+			if err != nil {
+				return nil, err
+			}
+			l, err := pl.Uids(opts)
+			if err != nil {
+				return nil, err
+			}
+			lists = append(lists, l)
+		}
+		uids = algo.MergeSorted(lists)
+		if len(uids.Uids) >= want {
+			break
+		}
+	}
+	if len(uids.Uids) >= arg.srcFn.numNeighbours {
+		return uids, nil
+	}
+
+	// Too few vectors were hashed close to the one we look for, which happens when the
+	// predicate doesn't have many values. Look at all of them instead, unless there are too
+	// many.
+	all, err := vectorUids(ctx, attr, arg.q.ReadTs, maxVectorScan)
+	if err != nil {
+		return nil, err
+	}
+	if all == nil {
+		return uids, nil
+	}
+	return all, nil
+}
+
+// vectorUids returns the uids having a value for the predicate, or nil if there are more than
+// max of them.
+func vectorUids(ctx context.Context, attr string, readTs uint64, max int) (*intern.List, error) {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	prefix := x.ParsedKey{Attr: attr}.DataPrefix()
+	it := posting.NewTxnPrefixIterator(txn, itOpt, prefix, prefix)
+	defer it.Close()
+
+	uids := &intern.List{}
+	for ; it.Valid(); it.Next() {
+		if it.UserMeta() == posting.BitEmptyPosting {
+			continue
+		}
+		if len(uids.Uids) == max {
+			return nil, nil
+		}
+		if len(uids.Uids)%1000 == 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+		}
+		uids.Uids = append(uids.Uids, x.Parse(it.Key()).Uid)
+	}
+	return uids, nil
+}
+
+// handleSimilarToFunction finds the nodes whose vectors are nearest to the one given to
+// similar_to. At root the candidates come from the vector index, inside a filter they are the
+// uids being filtered. The result has the neighbours sorted by uid, with their distances in the
+// value matrix.
+func handleSimilarToFunction(ctx context.Context, arg funcArgs) error {
+	attr := arg.q.Attr
+	typ, err := schema.State().TypeOf(attr)
+	if err != nil || typ != types.VFloatID {
+		return x.Errorf("similar_to is allowed only on predicates of type float32vector."+
+			" Got: %s", attr)
+	}
+	var found bool
+	for _, t := range schema.State().TokenizerNames(attr) {
+		if t == (tok.VectorTokenizer{}).Name() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return x.Errorf("Attribute %v does not have vector index for similar_to.", attr)
+	}
+
+	candidates := arg.q.UidList
+	if candidates == nil || len(candidates.Uids) == 0 {
+		if candidates, err = uidsForSimilarTo(ctx, attr, arg); err != nil {
+			return err
+		}
+	}
+
+	var neighbours []neighbour
+	for _, uid := range candidates.Uids {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		pl, err := posting.Get(x.DataKey(attr, uid))
+		if err != nil {
+			return err
+		}
+		val, err := pl.Value(arg.q.ReadTs)
+		if err == posting.ErrNoValue {
+			continue
+		} else if err != nil {
+			return err
+		}
+		vec, err := types.Convert(val, types.VFloatID)
+		if err != nil {
+			continue
+		}
+		dist, err := types.CosineDistance(vec.Value.([]float32), arg.srcFn.vector)
+		if err != nil {
+			// Vectors of other dimensions can't be neighbours.
+			continue
+		}
+		neighbours = append(neighbours, neighbour{uid: uid, dist: dist})
+	}
+
+	sort.Slice(neighbours, func(i, j int) bool {
+		if neighbours[i].dist != neighbours[j].dist {
+			return neighbours[i].dist < neighbours[j].dist
+		}
+		return neighbours[i].uid < neighbours[j].uid
+	})
+	if len(neighbours) > arg.srcFn.numNeighbours {
+		neighbours = neighbours[:arg.srcFn.numNeighbours]
+	}
+	sort.Slice(neighbours, func(i, j int) bool { return neighbours[i].uid < neighbours[j].uid })
+
+	uids := &intern.List{Uids: make([]uint64, 0, len(neighbours))}
+	for _, n := range neighbours {
+		uids.Uids = append(uids.Uids, n.uid)
+		dist := types.ValueForType(types.BinaryID)
+		if err := types.Marshal(types.Val{Tid: types.FloatID, Value: n.dist}, &dist); err != nil {
+			return err
+		}
+		arg.out.ValueMatrix = append(arg.out.ValueMatrix, &intern.ValueList{
+			Values: []*intern.TaskValue{{ValType: types.FloatID.Enum(), Val: dist.Value.([]byte)}},
+		})
+	}
+	arg.out.UidMatrix = append(arg.out.UidMatrix, uids)
+	return nil
+}
@@ -115,6 +115,22 @@ func TestProcessTask(t *testing.T) {
 		}, algo.ToUintsListForTest(r.UidMatrix))
 }
 
+func TestProcessTaskMemoryBudget(t *testing.T) {
+	initTest(t, `neighbour: uid .`)
+
+	// The uid lists take 56 bytes.
+	query := newQuery("neighbour", []uint64{10, 11, 12}, nil)
+	query.MemoryBudget = 56
+	_, err := helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+
+	query = newQuery("neighbour", []uint64{10, 11, 12}, nil)
+	query.MemoryBudget = 40
+	_, err = helpProcessTask(context.Background(), query, 1)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "memory budget while processing predicate neighbour")
+}
+
 // newQuery creates a Query task and returns it.
 func newQuery(attr string, uids []uint64, srcFunc []string) *intern.Query {
 	x.AssertTrue(uids == nil || srcFunc == nil)
@@ -285,6 +301,81 @@ func TestProcessTaskIndex(t *testing.T) {
 	}, algo.ToUintsListForTest(r.UidMatrix))
 }
 
+// newPrefixQuery returns a query for prefix, at root if uids is nil and as a filter otherwise.
+func newPrefixQuery(attr, prefix string, uids []uint64) *intern.Query {
+	q := &intern.Query{
+		SrcFunc: &intern.SrcFunction{Name: "prefix", Args: []string{prefix}},
+		Attr:    attr,
+		ReadTs:  timestamp(),
+	}
+	if uids != nil {
+		q.UidList = &intern.List{Uids: uids}
+	}
+	return q
+}
+
+func TestProcessTaskPrefix(t *testing.T) {
+	initTest(t, `
+		city: string @index(exact) .
+		city_words: string @index(term) .
+	`)
+	cities := map[uint64]string{
+		30: "Amsterdam",
+		31: "Amstelveen",
+		32: "Berlin",
+		33: "New Amsterdam",
+	}
+	for uid, city := range cities {
+		for _, attr := range []string{"city", "city_words"} {
+			edge := &intern.DirectedEdge{
+				Value:  []byte(city),
+				Label:  "author0",
+				Attr:   attr,
+				Entity: uid,
+			}
+			addEdge(t, edge, getOrCreate(x.DataKey(attr, uid)))
+		}
+	}
+
+	query := newPrefixQuery("city", "Amst", nil)
+	r, err := helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{30, 31}}, algo.ToUintsListForTest(r.UidMatrix))
+	require.EqualValues(t, 2, r.IndexKeys)
+
+	// The exact index is ordered by value, Amstelveen comes first.
+	query = newPrefixQuery("city", "Amst", nil)
+	query.First = 1
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{31}}, algo.ToUintsListForTest(r.UidMatrix))
+	require.EqualValues(t, 1, r.IndexKeys)
+
+	query = newPrefixQuery("city", "amst", nil)
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{nil}, algo.ToUintsListForTest(r.UidMatrix))
+
+	query = newPrefixQuery("city_words", "Amst", nil)
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{30, 31, 33}}, algo.ToUintsListForTest(r.UidMatrix))
+
+	// As a filter.
+	query = newPrefixQuery("city_words", "amster", []uint64{30, 32, 33})
+	r, err = helpProcessTask(context.Background(), query, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, [][]uint64{{30, 33}}, algo.ToUintsListForTest(r.UidMatrix))
+
+	query = newPrefixQuery("city_words", "new am", nil)
+	_, err = helpProcessTask(context.Background(), query, 1)
+	require.Error(t, err)
+
+	query = newPrefixQuery("neighbour", "a", nil)
+	_, err = helpProcessTask(context.Background(), query, 1)
+	require.Error(t, err)
+}
+
 /*
 func populateGraphForSort(t *testing.T, ps store.Store) {
 	edge := &intern.DirectedEdge{
@@ -615,3 +706,21 @@ func TestMain(m *testing.M) {
 	Init(ps)
 	os.Exit(m.Run())
 }
+
+func TestVectorUidsMax(t *testing.T) {
+	initTest(t, `vector_scan: uid .`)
+	for uid := uint64(1); uid <= 5; uid++ {
+		edge := &intern.DirectedEdge{Entity: uid, Attr: "vector_scan", ValueId: 2}
+		addEdge(t, edge, getOrCreate(x.DataKey("vector_scan", uid)))
+	}
+	readTs := timestamp()
+
+	uids, err := vectorUids(context.Background(), "vector_scan", readTs, 5)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, uids.Uids)
+
+	// Predicates with too many values aren't scanned.
+	uids, err = vectorUids(context.Background(), "vector_scan", readTs, 4)
+	require.NoError(t, err)
+	require.Nil(t, uids)
+}
@@ -7,10 +7,15 @@
 
 package x
 
+import "time"
+
 type Options struct {
 	DebugMode      bool
 	PortOffset     int
 	QueryEdgeLimit uint64
+	// Default limits of a query, which requests can override. Zero if there's no limit.
+	QueryTimeout      time.Duration
+	QueryMemoryBudget uint64
 }
 
 var Config Options
@@ -25,6 +25,7 @@ const (
 	// keys of same attributes are located together
 	defaultPrefix = byte(0x00)
 	byteSchema    = byte(0x01)
+	byteTypeDef   = byte(0x02)
 )
 
 func writeAttr(buf []byte, attr string) []byte {
@@ -49,6 +50,18 @@ func SchemaKey(attr string) []byte {
 	return buf
 }
 
+// TypeKey returns the key under which the definition of the given type is
+// stored. Like schema keys, type keys have their own prefix so that all of
+// them can be iterated over together.
+func TypeKey(name string) []byte {
+	buf := make([]byte, 1+2+len(name))
+	buf[0] = byteTypeDef
+	rest := buf[1:]
+
+	writeAttr(rest, name)
+	return buf
+}
+
 func DataKey(attr string, uid uint64) []byte {
 	buf := make([]byte, 2+len(attr)+2+8)
 	buf[0] = defaultPrefix
@@ -135,6 +148,12 @@ func (p ParsedKey) IsSchema() bool {
 	return p.bytePrefix == byteSchema
 }
 
+// IsTypeDef returns true if the key holds a type definition, in which case
+// Attr is the name of the type.
+func (p ParsedKey) IsTypeDef() bool {
+	return p.bytePrefix == byteTypeDef
+}
+
 func (p ParsedKey) IsType(typ byte) bool {
 	switch typ {
 	case ByteCount, ByteCountRev:
@@ -231,6 +250,13 @@ func SchemaPrefix() []byte {
 	return buf[:]
 }
 
+// TypePrefix returns the prefix for type definition keys.
+func TypePrefix() []byte {
+	var buf [1]byte
+	buf[0] = byteTypeDef
+	return buf[:]
+}
+
 // PredicatePrefix returns the prefix for all keys belonging
 // to this predicate except schema key.
 func PredicatePrefix(predicate string) []byte {
@@ -254,7 +280,7 @@ func Parse(key []byte) *ParsedKey {
 	k = k[sz:]
 
 	switch p.bytePrefix {
-	case byteSchema:
+	case byteSchema, byteTypeDef:
 		return p
 	default:
 	}
@@ -82,3 +82,17 @@ func TestSchemaKey(t *testing.T) {
 		require.Equal(t, sattr, pk.Attr)
 	}
 }
+
+func TestTypeKey(t *testing.T) {
+	var uid uint64
+	for uid = 0; uid < 1001; uid++ {
+		sattr := fmt.Sprintf("type:%d", uid)
+
+		key := TypeKey(sattr)
+		pk := Parse(key)
+
+		require.True(t, pk.IsTypeDef())
+		require.False(t, pk.IsSchema())
+		require.Equal(t, sattr, pk.Attr)
+	}
+}
@@ -49,6 +49,8 @@ const (
 
 	// The attr used to store list of predicates for a node.
 	PredicateListAttr = "_predicate_"
+	// The attr used to store the list of types of a node.
+	TypeAttr = "_type_"
 
 	PortZeroGrpc = 5080
 	PortZeroHTTP = 6080